@@ -0,0 +1,282 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// AnnotationQueuesService handles operations related to annotation queues for human review
+type AnnotationQueuesService service
+
+// AnnotationQueueObjectType represents the kind of object queued for review
+type AnnotationQueueObjectType string
+
+// Annotation queue object types
+const (
+	AnnotationQueueObjectTypeTrace       AnnotationQueueObjectType = "TRACE"
+	AnnotationQueueObjectTypeObservation AnnotationQueueObjectType = "OBSERVATION"
+	AnnotationQueueObjectTypeSession     AnnotationQueueObjectType = "SESSION"
+)
+
+// AnnotationQueueStatus represents the review status of a queue item
+type AnnotationQueueStatus string
+
+// Annotation queue item statuses
+const (
+	AnnotationQueueStatusPending   AnnotationQueueStatus = "PENDING"
+	AnnotationQueueStatusCompleted AnnotationQueueStatus = "COMPLETED"
+)
+
+// AnnotationQueue represents a queue of objects to be reviewed against a set of score configs
+type AnnotationQueue struct {
+	ID             string     `json:"id,omitempty"`
+	Name           string     `json:"name"`
+	Description    string     `json:"description,omitempty"`
+	ScoreConfigIDs []string   `json:"scoreConfigIds"`
+	CreatedAt      *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt      *time.Time `json:"updatedAt,omitempty"`
+}
+
+// AnnotationQueueList represents a page of annotation queues
+type AnnotationQueueList struct {
+	Data []AnnotationQueue `json:"data"`
+	Meta MetaResponse      `json:"meta"`
+}
+
+// AnnotationQueueItem represents an object queued for review
+type AnnotationQueueItem struct {
+	ID          string                    `json:"id,omitempty"`
+	QueueID     string                    `json:"queueId,omitempty"`
+	ObjectID    string                    `json:"objectId"`
+	ObjectType  AnnotationQueueObjectType `json:"objectType"`
+	Status      AnnotationQueueStatus     `json:"status,omitempty"`
+	CompletedAt *time.Time                `json:"completedAt,omitempty"`
+	CreatedAt   *time.Time                `json:"createdAt,omitempty"`
+	UpdatedAt   *time.Time                `json:"updatedAt,omitempty"`
+}
+
+// AnnotationQueueItemList represents a page of annotation queue items
+type AnnotationQueueItemList struct {
+	Data []AnnotationQueueItem `json:"data"`
+	Meta MetaResponse          `json:"meta"`
+}
+
+// AnnotationQueueItemFilter holds the query parameters for listing queue items. Zero values are omitted.
+type AnnotationQueueItemFilter struct {
+	Page   int
+	Limit  int
+	Status AnnotationQueueStatus
+}
+
+// AnnotationQueueAssignment represents a user assigned to review an annotation queue
+type AnnotationQueueAssignment struct {
+	UserID    string `json:"userId"`
+	ProjectID string `json:"projectId"`
+	QueueID   string `json:"queueId"`
+}
+
+// updateAnnotationQueueItemRequest represents the request body for updating a queue item
+type updateAnnotationQueueItemRequest struct {
+	Status AnnotationQueueStatus `json:"status"`
+}
+
+// annotationQueueAssignmentRequest represents the request body for (un)assigning a user
+type annotationQueueAssignmentRequest struct {
+	UserID string `json:"userId"`
+}
+
+// ListQueues retrieves a page of annotation queues. Zero page and limit use the server defaults.
+// https://api.reference.langfuse.com/#tag/annotationqueues/get/api/public/annotation-queues
+func (s *AnnotationQueuesService) ListQueues(ctx context.Context, page, limit int) (*AnnotationQueueList, error) {
+	params := url.Values{}
+	setInt(params, "page", page)
+	setInt(params, "limit", limit)
+
+	u := withQuery("/api/public/annotation-queues", params)
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing annotation queues: %w", err)
+	}
+
+	var queues AnnotationQueueList
+	err = json.Unmarshal(body, &queues)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling annotation queues data: %w", err)
+	}
+
+	return &queues, nil
+}
+
+// GetQueue retrieves an annotation queue by its ID
+// https://api.reference.langfuse.com/#tag/annotationqueues/get/api/public/annotation-queues/{queueId}
+func (s *AnnotationQueuesService) GetQueue(ctx context.Context, queueID string) (*AnnotationQueue, error) {
+	u := fmt.Sprintf("/api/public/annotation-queues/%s", url.PathEscape(queueID))
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching annotation queue: %w", err)
+	}
+
+	var queue AnnotationQueue
+	err = json.Unmarshal(body, &queue)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling annotation queue data: %w", err)
+	}
+
+	return &queue, nil
+}
+
+// ListItems retrieves a page of items of an annotation queue
+// https://api.reference.langfuse.com/#tag/annotationqueues/get/api/public/annotation-queues/{queueId}/items
+func (s *AnnotationQueuesService) ListItems(
+	ctx context.Context,
+	queueID string,
+	filter AnnotationQueueItemFilter,
+) (*AnnotationQueueItemList, error) {
+	params := url.Values{}
+	setInt(params, "page", filter.Page)
+	setInt(params, "limit", filter.Limit)
+	setString(params, "status", string(filter.Status))
+
+	u := withQuery(fmt.Sprintf("/api/public/annotation-queues/%s/items", url.PathEscape(queueID)), params)
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing annotation queue items: %w", err)
+	}
+
+	var items AnnotationQueueItemList
+	err = json.Unmarshal(body, &items)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling annotation queue items data: %w", err)
+	}
+
+	return &items, nil
+}
+
+// GetItem retrieves a single item of an annotation queue
+// https://api.reference.langfuse.com/#tag/annotationqueues/get/api/public/annotation-queues/{queueId}/items/{itemId}
+func (s *AnnotationQueuesService) GetItem(ctx context.Context, queueID, itemID string) (*AnnotationQueueItem, error) {
+	u := annotationQueueItemURL(queueID, itemID)
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching annotation queue item: %w", err)
+	}
+
+	var item AnnotationQueueItem
+	err = json.Unmarshal(body, &item)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling annotation queue item data: %w", err)
+	}
+
+	return &item, nil
+}
+
+// CreateItem adds a trace, observation or session to an annotation queue. New items are pending
+// unless item.Status says otherwise.
+// https://api.reference.langfuse.com/#tag/annotationqueues/post/api/public/annotation-queues/{queueId}/items
+func (s *AnnotationQueuesService) CreateItem(
+	ctx context.Context,
+	queueID string,
+	item AnnotationQueueItem,
+) (*AnnotationQueueItem, error) {
+	if item.ObjectID == "" || item.ObjectType == "" {
+		return nil, errors.New("error creating annotation queue item: object ID and object type are required")
+	}
+
+	u := fmt.Sprintf("/api/public/annotation-queues/%s/items", url.PathEscape(queueID))
+
+	body, err := s.client.DoWithContext(ctx, "POST", u, &item)
+	if err != nil {
+		return nil, fmt.Errorf("error creating annotation queue item: %w", err)
+	}
+
+	var created AnnotationQueueItem
+	err = json.Unmarshal(body, &created)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling created annotation queue item data: %w", err)
+	}
+
+	return &created, nil
+}
+
+// UpdateItemStatus moves an annotation queue item to the given status
+// https://api.reference.langfuse.com/#tag/annotationqueues/patch/api/public/annotation-queues/{queueId}/items/{itemId}
+func (s *AnnotationQueuesService) UpdateItemStatus(
+	ctx context.Context,
+	queueID, itemID string,
+	status AnnotationQueueStatus,
+) (*AnnotationQueueItem, error) {
+	u := annotationQueueItemURL(queueID, itemID)
+
+	body, err := s.client.DoWithContext(ctx, "PATCH", u, &updateAnnotationQueueItemRequest{Status: status})
+	if err != nil {
+		return nil, fmt.Errorf("error updating annotation queue item: %w", err)
+	}
+
+	var item AnnotationQueueItem
+	err = json.Unmarshal(body, &item)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling annotation queue item data: %w", err)
+	}
+
+	return &item, nil
+}
+
+// DeleteItem removes an item from an annotation queue
+// https://api.reference.langfuse.com/#tag/annotationqueues/delete/api/public/annotation-queues/{queueId}/items/{itemId}
+func (s *AnnotationQueuesService) DeleteItem(ctx context.Context, queueID, itemID string) error {
+	u := annotationQueueItemURL(queueID, itemID)
+
+	_, err := s.client.DoWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting annotation queue item: %w", err)
+	}
+
+	return nil
+}
+
+// AssignUser assigns a user to review the items of an annotation queue
+// https://api.reference.langfuse.com/#tag/annotationqueues/post/api/public/annotation-queues/{queueId}/assignments
+func (s *AnnotationQueuesService) AssignUser(
+	ctx context.Context,
+	queueID, userID string,
+) (*AnnotationQueueAssignment, error) {
+	u := fmt.Sprintf("/api/public/annotation-queues/%s/assignments", url.PathEscape(queueID))
+
+	body, err := s.client.DoWithContext(ctx, "POST", u, &annotationQueueAssignmentRequest{UserID: userID})
+	if err != nil {
+		return nil, fmt.Errorf("error assigning annotation queue: %w", err)
+	}
+
+	var assignment AnnotationQueueAssignment
+	err = json.Unmarshal(body, &assignment)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling annotation queue assignment data: %w", err)
+	}
+
+	return &assignment, nil
+}
+
+// UnassignUser removes a user's assignment from an annotation queue
+// https://api.reference.langfuse.com/#tag/annotationqueues/delete/api/public/annotation-queues/{queueId}/assignments
+func (s *AnnotationQueuesService) UnassignUser(ctx context.Context, queueID, userID string) error {
+	u := fmt.Sprintf("/api/public/annotation-queues/%s/assignments", url.PathEscape(queueID))
+
+	_, err := s.client.DoWithContext(ctx, "DELETE", u, &annotationQueueAssignmentRequest{UserID: userID})
+	if err != nil {
+		return fmt.Errorf("error unassigning annotation queue: %w", err)
+	}
+
+	return nil
+}
+
+func annotationQueueItemURL(queueID, itemID string) string {
+	return fmt.Sprintf("/api/public/annotation-queues/%s/items/%s", url.PathEscape(queueID), url.PathEscape(itemID))
+}
@@ -0,0 +1,228 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupAnnotationQueuesTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.AnnotationQueues = (*AnnotationQueuesService)(&service{client: client})
+
+	return client, server
+}
+
+func TestAnnotationQueuesService_ListQueues_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/annotation-queues" {
+			t.Errorf("Expected path /api/public/annotation-queues, got %s", r.URL.Path)
+		}
+
+		if r.URL.Query().Get("limit") != "10" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [{"id": "queue-1", "name": "Flagged", "scoreConfigIds": ["config-1"]}],
+			"meta": {"page": 1, "limit": 10, "totalItems": 1, "totalPages": 1}}`))
+	}
+
+	client, server := setupAnnotationQueuesTestClient(handler)
+	defer server.Close()
+
+	queues, err := client.AnnotationQueues.ListQueues(context.Background(), 0, 10)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(queues.Data) != 1 || queues.Data[0].ScoreConfigIDs[0] != "config-1" {
+		t.Errorf("Unexpected queues %+v", queues.Data)
+	}
+}
+
+func TestAnnotationQueuesService_GetQueue_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/annotation-queues/queue-1" {
+			t.Errorf("Expected path /api/public/annotation-queues/queue-1, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "queue-1", "name": "Flagged", "description": "Thumbs down", "scoreConfigIds": []}`))
+	}
+
+	client, server := setupAnnotationQueuesTestClient(handler)
+	defer server.Close()
+
+	queue, err := client.AnnotationQueues.GetQueue(context.Background(), "queue-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if queue.Description != "Thumbs down" {
+		t.Errorf("Unexpected queue %+v", queue)
+	}
+}
+
+func TestAnnotationQueuesService_Items(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/public/annotation-queues/queue-1/items":
+			if r.URL.Query().Get("status") != "PENDING" {
+				t.Errorf("Expected status PENDING, got %s", r.URL.RawQuery)
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"data": [{"id": "item-1", "queueId": "queue-1", "objectId": "trace-1",
+				"objectType": "TRACE", "status": "PENDING"}],
+				"meta": {"page": 1, "limit": 50, "totalItems": 1, "totalPages": 1}}`))
+		case r.Method == "GET" && r.URL.Path == "/api/public/annotation-queues/queue-1/items/item-1":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id": "item-1", "queueId": "queue-1", "objectId": "trace-1", "objectType": "TRACE",
+				"status": "PENDING"}`))
+		case r.Method == "POST" && r.URL.Path == "/api/public/annotation-queues/queue-1/items":
+			var item AnnotationQueueItem
+			if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			if item.ObjectID != "trace-2" || item.ObjectType != AnnotationQueueObjectTypeTrace {
+				t.Errorf("Unexpected item %+v", item)
+			}
+			item.ID, item.QueueID, item.Status = "item-2", "queue-1", AnnotationQueueStatusPending
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(item)
+		case r.Method == "PATCH" && r.URL.Path == "/api/public/annotation-queues/queue-1/items/item-1":
+			var payload map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			if payload["status"] != "COMPLETED" {
+				t.Errorf("Expected status COMPLETED, got %v", payload["status"])
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id": "item-1", "objectId": "trace-1", "objectType": "TRACE", "status": "COMPLETED",
+				"completedAt": "2024-01-01T00:00:00Z"}`))
+		case r.Method == "DELETE" && r.URL.Path == "/api/public/annotation-queues/queue-1/items/item-1":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success": true, "message": "deleted"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+
+	client, server := setupAnnotationQueuesTestClient(handler)
+	defer server.Close()
+
+	ctx := context.Background()
+
+	items, err := client.AnnotationQueues.ListItems(ctx, "queue-1", AnnotationQueueItemFilter{
+		Status: AnnotationQueueStatusPending,
+	})
+	if err != nil || len(items.Data) != 1 {
+		t.Fatalf("Unexpected list result %+v, %v", items, err)
+	}
+
+	item, err := client.AnnotationQueues.GetItem(ctx, "queue-1", "item-1")
+	if err != nil || item.ObjectID != "trace-1" {
+		t.Fatalf("Unexpected get result %+v, %v", item, err)
+	}
+
+	created, err := client.AnnotationQueues.CreateItem(ctx, "queue-1", AnnotationQueueItem{
+		ObjectID:   "trace-2",
+		ObjectType: AnnotationQueueObjectTypeTrace,
+	})
+	if err != nil || created.ID != "item-2" || created.Status != AnnotationQueueStatusPending {
+		t.Fatalf("Unexpected create result %+v, %v", created, err)
+	}
+
+	updated, err := client.AnnotationQueues.UpdateItemStatus(ctx, "queue-1", "item-1", AnnotationQueueStatusCompleted)
+	if err != nil || updated.Status != AnnotationQueueStatusCompleted || updated.CompletedAt == nil {
+		t.Fatalf("Unexpected update result %+v, %v", updated, err)
+	}
+
+	if err := client.AnnotationQueues.DeleteItem(ctx, "queue-1", "item-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestAnnotationQueuesService_CreateItem_RequiredFields(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request for invalid item")
+	}
+
+	client, server := setupAnnotationQueuesTestClient(handler)
+	defer server.Close()
+
+	_, err := client.AnnotationQueues.CreateItem(context.Background(), "queue-1", AnnotationQueueItem{ObjectID: "t"})
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error creating annotation queue item: object ID and object type are required"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
+
+func TestAnnotationQueuesService_Assignments(t *testing.T) {
+	var methods []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/annotation-queues/queue-1/assignments" {
+			t.Errorf("Expected path /api/public/annotation-queues/queue-1/assignments, got %s", r.URL.Path)
+		}
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+		if payload["userId"] != "user-1" {
+			t.Errorf("Expected userId user-1, got %v", payload["userId"])
+		}
+
+		methods = append(methods, r.Method)
+		w.WriteHeader(http.StatusOK)
+		if r.Method == "POST" {
+			w.Write([]byte(`{"userId": "user-1", "projectId": "project-1", "queueId": "queue-1"}`))
+		} else {
+			w.Write([]byte(`{"success": true}`))
+		}
+	}
+
+	client, server := setupAnnotationQueuesTestClient(handler)
+	defer server.Close()
+
+	assignment, err := client.AnnotationQueues.AssignUser(context.Background(), "queue-1", "user-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if assignment.ProjectID != "project-1" || assignment.QueueID != "queue-1" {
+		t.Errorf("Unexpected assignment %+v", assignment)
+	}
+
+	if err := client.AnnotationQueues.UnassignUser(context.Background(), "queue-1", "user-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(methods) != 2 || methods[0] != "POST" || methods[1] != "DELETE" {
+		t.Errorf("Expected POST then DELETE, got %v", methods)
+	}
+}
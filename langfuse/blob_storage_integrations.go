@@ -0,0 +1,158 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// BlobStorageIntegrationsService handles the scheduled exports of project data to
+// blob storage. It requires a client configured with an organization-scoped API key.
+type BlobStorageIntegrationsService service
+
+// BlobStorageType represents the kind of blob storage data is exported to
+type BlobStorageType string
+
+// Blob storage types
+const (
+	BlobStorageTypeS3               BlobStorageType = "S3"
+	BlobStorageTypeS3Compatible     BlobStorageType = "S3_COMPATIBLE"
+	BlobStorageTypeAzureBlobStorage BlobStorageType = "AZURE_BLOB_STORAGE"
+)
+
+// BlobStorageExportFrequency represents how often data is exported
+type BlobStorageExportFrequency string
+
+// Blob storage export frequencies
+const (
+	BlobStorageExportFrequencyHourly BlobStorageExportFrequency = "hourly"
+	BlobStorageExportFrequencyDaily  BlobStorageExportFrequency = "daily"
+	BlobStorageExportFrequencyWeekly BlobStorageExportFrequency = "weekly"
+)
+
+// BlobStorageFileType represents the format of exported files
+type BlobStorageFileType string
+
+// Blob storage file types
+const (
+	BlobStorageFileTypeJSON  BlobStorageFileType = "JSON"
+	BlobStorageFileTypeCSV   BlobStorageFileType = "CSV"
+	BlobStorageFileTypeJSONL BlobStorageFileType = "JSONL"
+)
+
+// BlobStorageExportMode represents which data the first export includes
+type BlobStorageExportMode string
+
+// Blob storage export modes
+const (
+	BlobStorageExportModeFullHistory    BlobStorageExportMode = "FULL_HISTORY"
+	BlobStorageExportModeFromToday      BlobStorageExportMode = "FROM_TODAY"
+	BlobStorageExportModeFromCustomDate BlobStorageExportMode = "FROM_CUSTOM_DATE"
+)
+
+// BlobStorageIntegration represents the blob storage export of a project. The
+// secret access key is never returned.
+type BlobStorageIntegration struct {
+	ID              string                     `json:"id"`
+	ProjectID       string                     `json:"projectId"`
+	Type            BlobStorageType            `json:"type"`
+	BucketName      string                     `json:"bucketName"`
+	Endpoint        string                     `json:"endpoint,omitempty"`
+	Region          string                     `json:"region"`
+	AccessKeyID     string                     `json:"accessKeyId,omitempty"`
+	Prefix          string                     `json:"prefix"`
+	ExportFrequency BlobStorageExportFrequency `json:"exportFrequency"`
+	Enabled         bool                       `json:"enabled"`
+	ForcePathStyle  bool                       `json:"forcePathStyle"`
+	FileType        BlobStorageFileType        `json:"fileType"`
+	ExportMode      BlobStorageExportMode      `json:"exportMode"`
+	ExportStartDate *time.Time                 `json:"exportStartDate,omitempty"`
+	NextSyncAt      *time.Time                 `json:"nextSyncAt,omitempty"`
+	LastSyncAt      *time.Time                 `json:"lastSyncAt,omitempty"`
+	CreatedAt       *time.Time                 `json:"createdAt,omitempty"`
+	UpdatedAt       *time.Time                 `json:"updatedAt,omitempty"`
+}
+
+// UpsertBlobStorageIntegrationRequest represents the request body for creating or
+// updating the blob storage export of a project. Endpoint is required for
+// S3-compatible storage and ExportStartDate for BlobStorageExportModeFromCustomDate.
+// The access keys may be omitted when the server uses its own credentials.
+type UpsertBlobStorageIntegrationRequest struct {
+	ProjectID       string                     `json:"projectId"`
+	Type            BlobStorageType            `json:"type"`
+	BucketName      string                     `json:"bucketName"`
+	Endpoint        string                     `json:"endpoint,omitempty"`
+	Region          string                     `json:"region"`
+	AccessKeyID     string                     `json:"accessKeyId,omitempty"`
+	SecretAccessKey string                     `json:"secretAccessKey,omitempty"`
+	Prefix          string                     `json:"prefix,omitempty"`
+	ExportFrequency BlobStorageExportFrequency `json:"exportFrequency"`
+	Enabled         bool                       `json:"enabled"`
+	ForcePathStyle  bool                       `json:"forcePathStyle"`
+	FileType        BlobStorageFileType        `json:"fileType"`
+	ExportMode      BlobStorageExportMode      `json:"exportMode"`
+	ExportStartDate *time.Time                 `json:"exportStartDate,omitempty"`
+}
+
+// blobStorageIntegrationList represents the response of the blob storage integrations endpoint
+type blobStorageIntegrationList struct {
+	Data []BlobStorageIntegration `json:"data"`
+}
+
+// List retrieves the blob storage integrations of all projects in the organization
+// https://api.reference.langfuse.com/#tag/blobstorageintegrations/get/api/public/integrations/blob-storage
+func (s *BlobStorageIntegrationsService) List(ctx context.Context) ([]BlobStorageIntegration, error) {
+	body, err := s.client.DoWithContext(ctx, "GET", "/api/public/integrations/blob-storage", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing blob storage integrations: %w", err)
+	}
+
+	var integrations blobStorageIntegrationList
+	err = json.Unmarshal(body, &integrations)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling blob storage integrations data: %w", err)
+	}
+
+	return integrations.Data, nil
+}
+
+// Upsert creates or replaces the blob storage integration of a project
+// https://api.reference.langfuse.com/#tag/blobstorageintegrations/put/api/public/integrations/blob-storage
+func (s *BlobStorageIntegrationsService) Upsert(
+	ctx context.Context,
+	integration UpsertBlobStorageIntegrationRequest,
+) (*BlobStorageIntegration, error) {
+	if integration.ProjectID == "" || integration.Type == "" || integration.BucketName == "" {
+		return nil, errors.New(
+			"error upserting blob storage integration: project ID, type and bucket name are required")
+	}
+
+	body, err := s.client.DoWithContext(ctx, "PUT", "/api/public/integrations/blob-storage", &integration)
+	if err != nil {
+		return nil, fmt.Errorf("error upserting blob storage integration: %w", err)
+	}
+
+	var upserted BlobStorageIntegration
+	err = json.Unmarshal(body, &upserted)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling blob storage integration data: %w", err)
+	}
+
+	return &upserted, nil
+}
+
+// Delete deletes a blob storage integration. Already exported files are kept.
+// https://api.reference.langfuse.com/#tag/blobstorageintegrations/delete/api/public/integrations/blob-storage/{id}
+func (s *BlobStorageIntegrationsService) Delete(ctx context.Context, id string) error {
+	u := fmt.Sprintf("/api/public/integrations/blob-storage/%s", url.PathEscape(id))
+
+	_, err := s.client.DoWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting blob storage integration: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupBlobStorageIntegrationsTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.BlobStorageIntegrations = (*BlobStorageIntegrationsService)(&service{client: client})
+
+	return client, server
+}
+
+func TestBlobStorageIntegrationsService_List_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/api/public/integrations/blob-storage" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [{"id": "int-1", "projectId": "project-1", "type": "S3", "bucketName": "exports",
+			"region": "eu-west-1", "prefix": "langfuse/", "exportFrequency": "daily", "enabled": true,
+			"forcePathStyle": false, "fileType": "JSONL", "exportMode": "FULL_HISTORY",
+			"lastSyncAt": "2024-01-01T00:00:00Z"}]}`))
+	}
+
+	client, server := setupBlobStorageIntegrationsTestClient(handler)
+	defer server.Close()
+
+	integrations, err := client.BlobStorageIntegrations.List(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(integrations) != 1 {
+		t.Fatalf("Expected 1 integration, got %d", len(integrations))
+	}
+
+	integration := integrations[0]
+	if integration.FileType != BlobStorageFileTypeJSONL ||
+		integration.ExportFrequency != BlobStorageExportFrequencyDaily ||
+		!integration.Enabled || integration.LastSyncAt == nil {
+		t.Errorf("Unexpected integration %+v", integration)
+	}
+}
+
+func TestBlobStorageIntegrationsService_Upsert_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/api/public/integrations/blob-storage" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		if payload["projectId"] != "project-1" || payload["type"] != "S3_COMPATIBLE" ||
+			payload["endpoint"] != "https://minio.example.com" || payload["secretAccessKey"] != "secret" ||
+			payload["forcePathStyle"] != true || payload["exportStartDate"] != "2024-06-01T00:00:00Z" {
+			t.Errorf("Unexpected payload %v", payload)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "int-1", "projectId": "project-1", "type": "S3_COMPATIBLE", "bucketName": "exports",
+			"endpoint": "https://minio.example.com", "region": "auto", "accessKeyId": "key", "prefix": "",
+			"exportFrequency": "hourly", "enabled": true, "forcePathStyle": true, "fileType": "CSV",
+			"exportMode": "FROM_CUSTOM_DATE", "exportStartDate": "2024-06-01T00:00:00Z"}`))
+	}
+
+	client, server := setupBlobStorageIntegrationsTestClient(handler)
+	defer server.Close()
+
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	integration, err := client.BlobStorageIntegrations.Upsert(context.Background(), UpsertBlobStorageIntegrationRequest{
+		ProjectID:       "project-1",
+		Type:            BlobStorageTypeS3Compatible,
+		BucketName:      "exports",
+		Endpoint:        "https://minio.example.com",
+		Region:          "auto",
+		AccessKeyID:     "key",
+		SecretAccessKey: "secret",
+		ExportFrequency: BlobStorageExportFrequencyHourly,
+		Enabled:         true,
+		ForcePathStyle:  true,
+		FileType:        BlobStorageFileTypeCSV,
+		ExportMode:      BlobStorageExportModeFromCustomDate,
+		ExportStartDate: &start,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if integration.ID != "int-1" || integration.ExportStartDate == nil || !integration.ExportStartDate.Equal(start) {
+		t.Errorf("Unexpected integration %+v", integration)
+	}
+}
+
+func TestBlobStorageIntegrationsService_Upsert_RequiredFields(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request for invalid integration")
+	}
+
+	client, server := setupBlobStorageIntegrationsTestClient(handler)
+	defer server.Close()
+
+	_, err := client.BlobStorageIntegrations.Upsert(context.Background(), UpsertBlobStorageIntegrationRequest{
+		ProjectID: "project-1",
+		Type:      BlobStorageTypeS3,
+	})
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error upserting blob storage integration: project ID, type and bucket name are required"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
+
+func TestBlobStorageIntegrationsService_Delete(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" || r.URL.Path != "/api/public/integrations/blob-storage/int-1" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message": "Blob storage integration deleted"}`))
+	}
+
+	client, server := setupBlobStorageIntegrationsTestClient(handler)
+	defer server.Close()
+
+	if err := client.BlobStorageIntegrations.Delete(context.Background(), "int-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestBlobStorageIntegrationsService_Delete_NotFound(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("not found"))
+	}
+
+	client, server := setupBlobStorageIntegrationsTestClient(handler)
+	defer server.Close()
+
+	err := client.BlobStorageIntegrations.Delete(context.Background(), "missing")
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error deleting blob storage integration: client error 404: not found"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
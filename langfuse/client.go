@@ -2,10 +2,14 @@ package langfuse
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
 	"net/url"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
@@ -19,14 +23,43 @@ const (
 	defaultMediaType = "*/*"
 )
 
+// ErrClientDisabled is returned by API calls of a client whose Config disables it
+var ErrClientDisabled = errors.New("langfuse client is disabled")
+
 // Client represents an ArgoCD client with retryable HTTP capabilities
 type Client struct {
 	retryableClient *retryablehttp.Client
 	baseUrl         string
 	base64Token     string
+	sampleRate      *float64
+	mask            MaskFunc
+	disabled        bool
+
+	// projectID caches the project of the API key, see ProjectsService.ProjectID
+	projectIDMu   sync.Mutex
+	projectID     string
+	projectIDCall *projectIDCall
 
-	Projects *ProjectsService
-	Prompts  *PromptsService
+	Projects                *ProjectsService
+	Prompts                 *PromptsService
+	OTel                    *OTelService
+	Ingestion               *IngestionService
+	Traces                  *TracesService
+	Observations            *ObservationsService
+	Sessions                *SessionsService
+	Scores                  *ScoresService
+	ScoreConfigs            *ScoreConfigsService
+	Datasets                *DatasetsService
+	Models                  *ModelsService
+	Metrics                 *MetricsService
+	Comments                *CommentsService
+	AnnotationQueues        *AnnotationQueuesService
+	Media                   *MediaService
+	Health                  *HealthService
+	Organizations           *OrganizationsService
+	SCIM                    *SCIMService
+	LLMConnections          *LLMConnectionsService
+	BlobStorageIntegrations *BlobStorageIntegrationsService
 }
 
 type service struct {
@@ -67,11 +100,32 @@ func NewClient(cfg *Config) *Client {
 		retryableClient: retryClient,
 		baseUrl:         cfg.ServerUrl,
 		base64Token:     cfg.Base64Token,
+		sampleRate:      cfg.SampleRate,
+		mask:            cfg.MaskFunc,
+		disabled:        !cfg.IsEnabled(),
 	}
 
 	// Initialize services with client reference
 	client.Projects = (*ProjectsService)(&service{client: client})
 	client.Prompts = (*PromptsService)(&service{client: client})
+	client.OTel = (*OTelService)(&service{client: client})
+	client.Ingestion = (*IngestionService)(&service{client: client})
+	client.Traces = (*TracesService)(&service{client: client})
+	client.Observations = (*ObservationsService)(&service{client: client})
+	client.Sessions = (*SessionsService)(&service{client: client})
+	client.Scores = (*ScoresService)(&service{client: client})
+	client.ScoreConfigs = (*ScoreConfigsService)(&service{client: client})
+	client.Datasets = (*DatasetsService)(&service{client: client})
+	client.Models = (*ModelsService)(&service{client: client})
+	client.Metrics = (*MetricsService)(&service{client: client})
+	client.Comments = (*CommentsService)(&service{client: client})
+	client.AnnotationQueues = (*AnnotationQueuesService)(&service{client: client})
+	client.Media = (*MediaService)(&service{client: client})
+	client.Health = (*HealthService)(&service{client: client})
+	client.Organizations = (*OrganizationsService)(&service{client: client})
+	client.SCIM = (*SCIMService)(&service{client: client})
+	client.LLMConnections = (*LLMConnectionsService)(&service{client: client})
+	client.BlobStorageIntegrations = (*BlobStorageIntegrationsService)(&service{client: client})
 
 	return client
 }
@@ -81,10 +135,12 @@ func (c *Client) Do(method, uri string) (body []byte, err error) {
 }
 
 func (c *Client) DoWithBody(method, uri string, payload interface{}) (body []byte, err error) {
-	if method == "" {
-		method = "GET"
-	}
+	return c.DoWithContext(context.Background(), method, uri, payload)
+}
 
+// DoWithContext behaves like DoWithBody but binds the request to ctx, so that
+// cancellation and deadlines abort both the request and any pending retries.
+func (c *Client) DoWithContext(ctx context.Context, method, uri string, payload interface{}) (body []byte, err error) {
 	var reqBody io.Reader
 	if payload != nil {
 		jsonData, err := json.Marshal(payload)
@@ -94,6 +150,26 @@ func (c *Client) DoWithBody(method, uri string, payload interface{}) (body []byt
 		reqBody = bytes.NewBuffer(jsonData)
 	}
 
+	return c.doRequest(ctx, method, uri, reqBody, "application/json")
+}
+
+// doRequest sends an already encoded request body with the given content type,
+// applying authentication, retries and status code handling. No request is made
+// when the client is disabled.
+func (c *Client) doRequest(
+	ctx context.Context,
+	method, uri string,
+	reqBody io.Reader,
+	contentType string,
+) (body []byte, err error) {
+	if c.disabled {
+		return nil, ErrClientDisabled
+	}
+
+	if method == "" {
+		method = "GET"
+	}
+
 	// Parse the full URL to properly handle percent-encoded path segments
 	fullURL := c.baseUrl + uri
 	parsedURL, err := url.Parse(fullURL)
@@ -105,7 +181,7 @@ func (c *Client) DoWithBody(method, uri string, payload interface{}) (body []byt
 	// This is necessary because url.Parse decodes the path by default
 	parsedURL.RawPath = parsedURL.EscapedPath()
 
-	req, err := retryablehttp.NewRequest(method, parsedURL.String(), reqBody)
+	req, err := retryablehttp.NewRequestWithContext(ctx, method, parsedURL.String(), reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
 	}
@@ -119,11 +195,11 @@ func (c *Client) DoWithBody(method, uri string, payload interface{}) (body []byt
 
 	// Set headers
 	req.Header.Set("Authorization", fmt.Sprintf("Basic %s", c.base64Token))
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 	req.Header.Set("Accept", defaultMediaType)
 	req.Header.Set("User-Agent", defaultUserAgent)
 
-	resp, err := c.retryableClient.Do(req)
+	resp, err := c.send(req)
 	if err != nil {
 		return nil, fmt.Errorf("error making request: %w", err)
 	}
@@ -157,3 +233,36 @@ func (c *Client) DoWithBody(method, uri string, payload interface{}) (body []byt
 	}
 	return body, nil
 }
+
+type singleAttemptContextKey struct{}
+
+// withoutRetries returns a copy of ctx under which requests are sent exactly
+// once, so that failures surface immediately and timings cover a single round
+// trip. It is used by health checks.
+func withoutRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, singleAttemptContextKey{}, true)
+}
+
+// send performs the request, retrying it according to the retry policy unless
+// its context was created by withoutRetries
+func (c *Client) send(req *retryablehttp.Request) (*http.Response, error) {
+	if singleAttempt, _ := req.Context().Value(singleAttemptContextKey{}).(bool); !singleAttempt {
+		return c.retryableClient.Do(req)
+	}
+
+	body, err := req.BodyBytes()
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+	}
+
+	httpClient := c.retryableClient.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return httpClient.Do(req.Request)
+}
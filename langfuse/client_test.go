@@ -1,8 +1,10 @@
 package langfuse
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -30,6 +32,24 @@ func setupTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
 
 	client.Projects = (*ProjectsService)(&service{client: client})
 	client.Prompts = (*PromptsService)(&service{client: client})
+	client.OTel = (*OTelService)(&service{client: client})
+	client.Ingestion = (*IngestionService)(&service{client: client})
+	client.Traces = (*TracesService)(&service{client: client})
+	client.Observations = (*ObservationsService)(&service{client: client})
+	client.Sessions = (*SessionsService)(&service{client: client})
+	client.Scores = (*ScoresService)(&service{client: client})
+	client.ScoreConfigs = (*ScoreConfigsService)(&service{client: client})
+	client.Datasets = (*DatasetsService)(&service{client: client})
+	client.Models = (*ModelsService)(&service{client: client})
+	client.Metrics = (*MetricsService)(&service{client: client})
+	client.Comments = (*CommentsService)(&service{client: client})
+	client.AnnotationQueues = (*AnnotationQueuesService)(&service{client: client})
+	client.Media = (*MediaService)(&service{client: client})
+	client.Health = (*HealthService)(&service{client: client})
+	client.Organizations = (*OrganizationsService)(&service{client: client})
+	client.SCIM = (*SCIMService)(&service{client: client})
+	client.LLMConnections = (*LLMConnectionsService)(&service{client: client})
+	client.BlobStorageIntegrations = (*BlobStorageIntegrationsService)(&service{client: client})
 
 	return client, server
 }
@@ -265,6 +285,78 @@ func TestNewClient(t *testing.T) {
 		t.Error("Expected Prompts service to be initialized")
 	}
 
+	if client.OTel == nil {
+		t.Error("Expected OTel service to be initialized")
+	}
+
+	if client.Ingestion == nil {
+		t.Error("Expected Ingestion service to be initialized")
+	}
+
+	if client.Traces == nil {
+		t.Error("Expected Traces service to be initialized")
+	}
+
+	if client.Observations == nil {
+		t.Error("Expected Observations service to be initialized")
+	}
+
+	if client.Sessions == nil {
+		t.Error("Expected Sessions service to be initialized")
+	}
+
+	if client.Scores == nil {
+		t.Error("Expected Scores service to be initialized")
+	}
+
+	if client.ScoreConfigs == nil {
+		t.Error("Expected ScoreConfigs service to be initialized")
+	}
+
+	if client.Datasets == nil {
+		t.Error("Expected Datasets service to be initialized")
+	}
+
+	if client.Models == nil {
+		t.Error("Expected Models service to be initialized")
+	}
+
+	if client.Metrics == nil {
+		t.Error("Expected Metrics service to be initialized")
+	}
+
+	if client.Comments == nil {
+		t.Error("Expected Comments service to be initialized")
+	}
+
+	if client.AnnotationQueues == nil {
+		t.Error("Expected AnnotationQueues service to be initialized")
+	}
+
+	if client.Media == nil {
+		t.Error("Expected Media service to be initialized")
+	}
+
+	if client.Health == nil {
+		t.Error("Expected Health service to be initialized")
+	}
+
+	if client.Organizations == nil {
+		t.Error("Expected Organizations service to be initialized")
+	}
+
+	if client.SCIM == nil {
+		t.Error("Expected SCIM service to be initialized")
+	}
+
+	if client.LLMConnections == nil {
+		t.Error("Expected LLMConnections service to be initialized")
+	}
+
+	if client.BlobStorageIntegrations == nil {
+		t.Error("Expected BlobStorageIntegrations service to be initialized")
+	}
+
 	if client.retryableClient == nil {
 		t.Error("Expected retryableClient to be initialized")
 	}
@@ -546,3 +638,67 @@ func TestDoWithBody_MultipleSequentialRequests(t *testing.T) {
 		t.Errorf("Expected 5 requests, got %d", requestCount)
 	}
 }
+
+func TestClient_DoWithContext_Cancelled(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	client, server := setupTestClient(handler)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.DoWithContext(ctx, "GET", "/test", nil)
+	if err == nil {
+		t.Fatal("Expected error for cancelled context, got nil")
+	}
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestClient_Disabled(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Expected no request from disabled client, got %s %s", r.Method, r.URL.Path)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(handler))
+	defer server.Close()
+
+	client := NewClient(&Config{ServerUrl: server.URL, Enabled: new(bool)})
+
+	_, err := client.Do("GET", "/test")
+	if !errors.Is(err, ErrClientDisabled) {
+		t.Errorf("Expected ErrClientDisabled, got %v", err)
+	}
+
+	_, err = client.Prompts.GetPromptByName("my-prompt", "production", nil)
+	if !errors.Is(err, ErrClientDisabled) {
+		t.Errorf("Expected prompt fetch to fail with ErrClientDisabled, got %v", err)
+	}
+
+	fallback := &Prompt{Name: "my-prompt", Type: "text", Prompt: "Hello {{name}}"}
+	prompt, err := client.Prompts.GetPromptByName("my-prompt", "production", nil, WithFallbackPrompt(fallback))
+	if err != nil {
+		t.Fatalf("Expected fallback prompt, got %v", err)
+	}
+	if prompt.Prompt != "Hello {{name}}" || !prompt.IsFallback || fallback.IsFallback {
+		t.Errorf("Expected a copy of the fallback marked as fallback, got %+v", prompt)
+	}
+
+	events := []*IngestionEvent{NewTraceCreateEvent(&TraceBody{Name: "trace"})}
+	response, err := client.Ingestion.Batch(context.Background(), events)
+	if err != nil {
+		t.Errorf("Expected ingestion to be a no-op, got %v", err)
+	}
+	if response == nil || len(response.Successes) != 0 {
+		t.Errorf("Expected empty ingestion response, got %+v", response)
+	}
+
+	if err := client.OTel.ExportTraces(context.Background(), []byte{1}); err != nil {
+		t.Errorf("Expected OTLP export to be a no-op, got %v", err)
+	}
+}
@@ -0,0 +1,151 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"time"
+)
+
+// CommentsService handles operations related to comments on traces, observations, sessions and prompts
+type CommentsService service
+
+// CommentObjectType represents the kind of object a comment is attached to
+type CommentObjectType string
+
+// Comment object types
+const (
+	CommentObjectTypeTrace       CommentObjectType = "TRACE"
+	CommentObjectTypeObservation CommentObjectType = "OBSERVATION"
+	CommentObjectTypeSession     CommentObjectType = "SESSION"
+	CommentObjectTypePrompt      CommentObjectType = "PROMPT"
+)
+
+// Comment represents a comment on a Langfuse object. ProjectID is resolved from
+// the API key when creating a comment without one.
+type Comment struct {
+	ID           string            `json:"id,omitempty"`
+	ProjectID    string            `json:"projectId,omitempty"`
+	ObjectType   CommentObjectType `json:"objectType"`
+	ObjectID     string            `json:"objectId"`
+	Content      string            `json:"content"`
+	AuthorUserID string            `json:"authorUserId,omitempty"`
+	CreatedAt    *time.Time        `json:"createdAt,omitempty"`
+	UpdatedAt    *time.Time        `json:"updatedAt,omitempty"`
+}
+
+// CommentList represents a page of comments
+type CommentList struct {
+	Data []Comment    `json:"data"`
+	Meta MetaResponse `json:"meta"`
+}
+
+// CommentFilter holds the query parameters for listing comments. ObjectID requires ObjectType.
+type CommentFilter struct {
+	Page         int
+	Limit        int
+	ObjectType   CommentObjectType
+	ObjectID     string
+	AuthorUserID string
+}
+
+// createCommentResponse represents the response of the create comment endpoint
+type createCommentResponse struct {
+	ID string `json:"id"`
+}
+
+// Create adds a comment to an object and returns it with its ID set
+// https://api.reference.langfuse.com/#tag/comments/post/api/public/comments
+func (s *CommentsService) Create(ctx context.Context, comment Comment) (*Comment, error) {
+	if comment.ObjectType == "" || comment.ObjectID == "" {
+		return nil, errors.New("error creating comment: object type and object ID are required")
+	}
+	if comment.Content == "" {
+		return nil, errors.New("error creating comment: content is required")
+	}
+
+	if comment.ProjectID == "" {
+		projectID, err := s.client.Projects.ProjectID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error creating comment: %w", err)
+		}
+		comment.ProjectID = projectID
+	}
+
+	u := "/api/public/comments"
+
+	body, err := s.client.DoWithContext(ctx, "POST", u, &comment)
+	if err != nil {
+		return nil, fmt.Errorf("error creating comment: %w", err)
+	}
+
+	var created createCommentResponse
+	err = json.Unmarshal(body, &created)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling created comment data: %w", err)
+	}
+
+	comment.ID = created.ID
+
+	return &comment, nil
+}
+
+// Get retrieves a comment by its ID
+// https://api.reference.langfuse.com/#tag/comments/get/api/public/comments/{commentId}
+func (s *CommentsService) Get(ctx context.Context, id string) (*Comment, error) {
+	u := fmt.Sprintf("/api/public/comments/%s", url.PathEscape(id))
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching comment: %w", err)
+	}
+
+	var comment Comment
+	err = json.Unmarshal(body, &comment)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling comment data: %w", err)
+	}
+
+	return &comment, nil
+}
+
+// List retrieves a page of comments matching the filter
+// https://api.reference.langfuse.com/#tag/comments/get/api/public/comments
+func (s *CommentsService) List(ctx context.Context, filter CommentFilter) (*CommentList, error) {
+	params := url.Values{}
+	setInt(params, "page", filter.Page)
+	setInt(params, "limit", filter.Limit)
+	setString(params, "objectType", string(filter.ObjectType))
+	setString(params, "objectId", filter.ObjectID)
+	setString(params, "authorUserId", filter.AuthorUserID)
+
+	u := withQuery("/api/public/comments", params)
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing comments: %w", err)
+	}
+
+	var comments CommentList
+	err = json.Unmarshal(body, &comments)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling comments data: %w", err)
+	}
+
+	return &comments, nil
+}
+
+// All iterates over all comments matching the filter, fetching pages as needed starting at filter.Page
+func (s *CommentsService) All(ctx context.Context, filter CommentFilter) iter.Seq2[Comment, error] {
+	return paginate(ctx, filter.Page, func(ctx context.Context, page int) ([]Comment, *MetaResponse, error) {
+		filter.Page = page
+		comments, err := s.List(ctx, filter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return comments.Data, &comments.Meta, nil
+	})
+}
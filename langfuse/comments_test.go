@@ -0,0 +1,191 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupCommentsTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.Comments = (*CommentsService)(&service{client: client})
+	client.Projects = (*ProjectsService)(&service{client: client})
+
+	return client, server
+}
+
+func TestCommentsService_Create_ResolvesProjectID(t *testing.T) {
+	projectRequests := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/public/projects":
+			projectRequests++
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"data": [{"id": "project-1", "name": "Test Project"}]}`))
+		case "/api/public/comments":
+			if r.Method != "POST" {
+				t.Errorf("Expected POST method, got %s", r.Method)
+			}
+
+			var comment Comment
+			if err := json.NewDecoder(r.Body).Decode(&comment); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+
+			expected := Comment{
+				ProjectID:    "project-1",
+				ObjectType:   CommentObjectTypeTrace,
+				ObjectID:     "trace-1",
+				Content:      "Hallucinated the refund policy",
+				AuthorUserID: "reviewer-1",
+			}
+			if !reflect.DeepEqual(comment, expected) {
+				t.Errorf("Expected %+v, got %+v", expected, comment)
+			}
+
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id": "comment-1"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+
+	client, server := setupCommentsTestClient(handler)
+	defer server.Close()
+
+	for range 2 {
+		comment, err := client.Comments.Create(context.Background(), Comment{
+			ObjectType:   CommentObjectTypeTrace,
+			ObjectID:     "trace-1",
+			Content:      "Hallucinated the refund policy",
+			AuthorUserID: "reviewer-1",
+		})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if comment.ID != "comment-1" || comment.ProjectID != "project-1" {
+			t.Errorf("Unexpected comment %+v", comment)
+		}
+	}
+
+	if projectRequests != 1 {
+		t.Errorf("Expected project ID to be resolved once, got %d requests", projectRequests)
+	}
+}
+
+func TestCommentsService_Create_RequiredFields(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request for invalid comment")
+	}
+
+	client, server := setupCommentsTestClient(handler)
+	defer server.Close()
+
+	tests := []struct {
+		name          string
+		comment       Comment
+		expectedError string
+	}{
+		{
+			name:          "missing object",
+			comment:       Comment{ObjectType: CommentObjectTypeSession, Content: "x"},
+			expectedError: "error creating comment: object type and object ID are required",
+		},
+		{
+			name:          "missing content",
+			comment:       Comment{ObjectType: CommentObjectTypeSession, ObjectID: "s1"},
+			expectedError: "error creating comment: content is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.Comments.Create(context.Background(), tt.comment)
+			if err == nil || err.Error() != tt.expectedError {
+				t.Errorf("Expected error '%s', got '%v'", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestCommentsService_Get_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/comments/comment-1" {
+			t.Errorf("Expected path /api/public/comments/comment-1, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "comment-1", "projectId": "project-1", "objectType": "OBSERVATION",
+			"objectId": "obs-1", "content": "Wrong tool call", "createdAt": "2024-01-01T00:00:00Z"}`))
+	}
+
+	client, server := setupCommentsTestClient(handler)
+	defer server.Close()
+
+	comment, err := client.Comments.Get(context.Background(), "comment-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if comment.ObjectType != CommentObjectTypeObservation || comment.Content != "Wrong tool call" {
+		t.Errorf("Unexpected comment %+v", comment)
+	}
+}
+
+func TestCommentsService_List_Filter(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		expected := map[string][]string{
+			"objectType":   {"PROMPT"},
+			"objectId":     {"prompt-1"},
+			"authorUserId": {"reviewer-1"},
+			"limit":        {"10"},
+		}
+		for key, values := range expected {
+			if !reflect.DeepEqual(query[key], values) {
+				t.Errorf("Expected %s=%v, got %v", key, values, query[key])
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [{"id": "comment-1", "objectType": "PROMPT", "objectId": "prompt-1", "content": "ok"}],
+			"meta": {"page": 1, "limit": 10, "totalItems": 1, "totalPages": 1}}`))
+	}
+
+	client, server := setupCommentsTestClient(handler)
+	defer server.Close()
+
+	comments, err := client.Comments.List(context.Background(), CommentFilter{
+		Limit:        10,
+		ObjectType:   CommentObjectTypePrompt,
+		ObjectID:     "prompt-1",
+		AuthorUserID: "reviewer-1",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(comments.Data) != 1 || comments.Data[0].ID != "comment-1" {
+		t.Errorf("Unexpected comments %+v", comments.Data)
+	}
+}
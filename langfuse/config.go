@@ -9,11 +9,22 @@ import (
 
 // Config holds the configuration for ArgoCD client operations.
 // Only ServerUrl and AuthToken are required fields; AppName and Revision are optional.
+//
+// SampleRate and MaskFunc control which ingestion data leaves the process:
+// SampleRate keeps the given fraction of traces, where 0 drops every trace and a
+// nil value keeps all of them, and MaskFunc, if set, is applied to the input,
+// output and metadata of every event.
+//
+// Enabled acts as a kill switch: when set to false the client makes no network
+// calls and no credentials are required. A nil value means enabled.
 type Config struct {
-	ServerUrl   string `mapstructure:"server_url"`
-	PublicKey   string `mapstructure:"public_key"`
-	SecretKey   string `mapstructure:"secret_key"`
-	Base64Token string `mapstructure:"base64_token"`
+	ServerUrl   string   `mapstructure:"server_url"`
+	PublicKey   string   `mapstructure:"public_key"`
+	SecretKey   string   `mapstructure:"secret_key"`
+	Base64Token string   `mapstructure:"base64_token"`
+	SampleRate  *float64 `mapstructure:"sample_rate"`
+	MaskFunc    MaskFunc `mapstructure:"-"`
+	Enabled     *bool    `mapstructure:"enabled"`
 }
 
 // NewConfig creates a new Config instance with the provided values.
@@ -46,6 +57,23 @@ func NewConfig(serverUrl, publicKey, secretKey string) (*Config, error) {
 	return cfg, nil
 }
 
+// NewDisabledConfig creates a Config that turns the client into a no-op, see
+// Config.Enabled. No server URL or keys are required.
+//
+// Example:
+//
+//	client := langfuse.NewClient(langfuse.NewDisabledConfig())
+func NewDisabledConfig() *Config {
+	enabled := false
+	return &Config{Enabled: &enabled}
+}
+
+// IsEnabled reports whether the client may make network calls. It is true
+// unless Enabled is explicitly set to false.
+func (c *Config) IsEnabled() bool {
+	return c.Enabled == nil || *c.Enabled
+}
+
 // ConfigLoaderInterface defines the contract for configuration loading
 type ConfigLoaderInterface interface {
 	// LoadConfigFromEnvVars loads configuration from environment variables
@@ -63,6 +91,10 @@ type ConfigLoaderInterface interface {
 //   - LANGFUSE_SERVER_URL -> ServerUrl (required)
 //   - LANGFUSE_PUBLIC_KEY -> PublicKey (required)
 //   - LANGFUSE_SECRET_KEY -> SecretKey (required)
+//   - LANGFUSE_SAMPLE_RATE -> SampleRate (optional, between 0 and 1, defaults to 1)
+//   - LANGFUSE_TRACING_ENABLED -> Enabled (optional, defaults to true)
+//
+// When LANGFUSE_TRACING_ENABLED is false, the server URL and keys are not required.
 //
 // Returns the loaded configuration or an error if required environment variables
 // are missing or if there are issues with configuration binding or validation.
@@ -76,6 +108,12 @@ func LoadConfigFromEnvVars() (*Config, error) {
 	if err := viper.BindEnv("secret_key", "LANGFUSE_SECRET_KEY"); err != nil {
 		return nil, fmt.Errorf("error binding LANGFUSE_SECRET_KEY: %w", err)
 	}
+	if err := viper.BindEnv("sample_rate", "LANGFUSE_SAMPLE_RATE"); err != nil {
+		return nil, fmt.Errorf("error binding LANGFUSE_SAMPLE_RATE: %w", err)
+	}
+	if err := viper.BindEnv("enabled", "LANGFUSE_TRACING_ENABLED"); err != nil {
+		return nil, fmt.Errorf("error binding LANGFUSE_TRACING_ENABLED: %w", err)
+	}
 
 	viper.AutomaticEnv()
 	var config Config
@@ -93,7 +131,16 @@ func LoadConfigFromEnvVars() (*Config, error) {
 
 // validateConfig validates that all required configuration fields are present.
 // Currently only validates ServerUrl and base64Token as required fields.
+// A disabled configuration requires none of them.
 func validateConfig(config *Config) error {
+	if !config.IsEnabled() {
+		if config.PublicKey != "" && config.SecretKey != "" {
+			config.Base64Token = base64.StdEncoding.EncodeToString(
+				[]byte(fmt.Sprintf("%s:%s", config.PublicKey, config.SecretKey)))
+		}
+		return nil
+	}
+
 	if config.ServerUrl == "" {
 		return fmt.Errorf("LANGFUSE_SERVER_URL is required")
 	}
@@ -106,6 +153,10 @@ func validateConfig(config *Config) error {
 		return fmt.Errorf("LANGFUSE_SECRET_KEY is required")
 	}
 
+	if config.SampleRate != nil && (*config.SampleRate < 0 || *config.SampleRate > 1) {
+		return fmt.Errorf("LANGFUSE_SAMPLE_RATE must be between 0 and 1")
+	}
+
 	if config.PublicKey != "" && config.SecretKey != "" {
 		config.Base64Token = base64.StdEncoding.EncodeToString(
 			[]byte(fmt.Sprintf("%s:%s", config.PublicKey, config.SecretKey)))
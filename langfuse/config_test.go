@@ -15,6 +15,8 @@ func resetViper() {
 	os.Unsetenv("LANGFUSE_SERVER_URL")
 	os.Unsetenv("LANGFUSE_PUBLIC_KEY")
 	os.Unsetenv("LANGFUSE_SECRET_KEY")
+	os.Unsetenv("LANGFUSE_SAMPLE_RATE")
+	os.Unsetenv("LANGFUSE_TRACING_ENABLED")
 }
 
 func TestLoadConfig_Success(t *testing.T) {
@@ -1046,3 +1048,160 @@ func TestLoadConfigFromEnvVars_SequentialCallsWithDifferentValues(t *testing.T)
 		})
 	}
 }
+
+func TestLoadConfigFromEnvVars_SampleRate(t *testing.T) {
+	defer resetViper()
+
+	os.Setenv("LANGFUSE_SERVER_URL", "https://test.langfuse.com")
+	os.Setenv("LANGFUSE_PUBLIC_KEY", "test-public-key")
+	os.Setenv("LANGFUSE_SECRET_KEY", "test-secret-key")
+	os.Setenv("LANGFUSE_SAMPLE_RATE", "0.25")
+
+	config, err := LoadConfigFromEnvVars()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.SampleRate == nil || *config.SampleRate != 0.25 {
+		t.Errorf("Expected SampleRate 0.25, got %v", config.SampleRate)
+	}
+}
+
+func TestLoadConfigFromEnvVars_SampleRateZero(t *testing.T) {
+	defer resetViper()
+
+	os.Setenv("LANGFUSE_SERVER_URL", "https://test.langfuse.com")
+	os.Setenv("LANGFUSE_PUBLIC_KEY", "test-public-key")
+	os.Setenv("LANGFUSE_SECRET_KEY", "test-secret-key")
+	os.Setenv("LANGFUSE_SAMPLE_RATE", "0")
+
+	config, err := LoadConfigFromEnvVars()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.SampleRate == nil || *config.SampleRate != 0 {
+		t.Errorf("Expected SampleRate 0, got %v", config.SampleRate)
+	}
+}
+
+func TestLoadConfigFromEnvVars_SampleRateUnset(t *testing.T) {
+	defer resetViper()
+
+	os.Setenv("LANGFUSE_SERVER_URL", "https://test.langfuse.com")
+	os.Setenv("LANGFUSE_PUBLIC_KEY", "test-public-key")
+	os.Setenv("LANGFUSE_SECRET_KEY", "test-secret-key")
+	os.Unsetenv("LANGFUSE_SAMPLE_RATE")
+
+	config, err := LoadConfigFromEnvVars()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.SampleRate != nil {
+		t.Errorf("Expected SampleRate to be unset, got %v", *config.SampleRate)
+	}
+}
+
+func TestValidateConfig_InvalidSampleRate(t *testing.T) {
+	for _, rate := range []float64{-0.1, 1.5} {
+		testConfig := &Config{
+			ServerUrl:  "https://test.langfuse.com",
+			PublicKey:  "test-public-key",
+			SecretKey:  "test-secret-key",
+			SampleRate: &rate,
+		}
+
+		err := validateConfig(testConfig)
+		if err == nil {
+			t.Fatalf("Expected error for sample rate %v, got nil", rate)
+		}
+
+		expectedError := "LANGFUSE_SAMPLE_RATE must be between 0 and 1"
+		if err.Error() != expectedError {
+			t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+		}
+	}
+}
+
+func TestLoadConfigFromEnvVars_TracingDisabled(t *testing.T) {
+	defer resetViper()
+
+	os.Unsetenv("LANGFUSE_SERVER_URL")
+	os.Unsetenv("LANGFUSE_PUBLIC_KEY")
+	os.Unsetenv("LANGFUSE_SECRET_KEY")
+	os.Setenv("LANGFUSE_TRACING_ENABLED", "false")
+
+	config, err := LoadConfigFromEnvVars()
+	if err != nil {
+		t.Fatalf("Expected no error for disabled config without keys, got %v", err)
+	}
+
+	if config.IsEnabled() {
+		t.Error("Expected config to be disabled")
+	}
+}
+
+func TestLoadConfigFromEnvVars_TracingEnabledByDefault(t *testing.T) {
+	defer resetViper()
+
+	os.Setenv("LANGFUSE_SERVER_URL", "https://test.langfuse.com")
+	os.Setenv("LANGFUSE_PUBLIC_KEY", "test-public-key")
+	os.Setenv("LANGFUSE_SECRET_KEY", "test-secret-key")
+
+	config, err := LoadConfigFromEnvVars()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.Enabled != nil {
+		t.Errorf("Expected Enabled to be unset, got %v", *config.Enabled)
+	}
+
+	if !config.IsEnabled() {
+		t.Error("Expected config to be enabled by default")
+	}
+}
+
+func TestLoadConfigFromEnvVars_TracingExplicitlyEnabled(t *testing.T) {
+	defer resetViper()
+
+	os.Unsetenv("LANGFUSE_SERVER_URL")
+	os.Setenv("LANGFUSE_PUBLIC_KEY", "test-public-key")
+	os.Setenv("LANGFUSE_SECRET_KEY", "test-secret-key")
+	os.Setenv("LANGFUSE_TRACING_ENABLED", "true")
+
+	_, err := LoadConfigFromEnvVars()
+	if err == nil {
+		t.Fatal("Expected error for enabled config without server URL, got nil")
+	}
+}
+
+func TestNewDisabledConfig(t *testing.T) {
+	config := NewDisabledConfig()
+
+	if config.IsEnabled() {
+		t.Error("Expected config to be disabled")
+	}
+
+	if err := validateConfig(config); err != nil {
+		t.Fatalf("Expected no error for disabled config, got %v", err)
+	}
+}
+
+func TestValidateConfig_Disabled(t *testing.T) {
+	testConfig := &Config{
+		Enabled:   new(bool),
+		PublicKey: "test-public-key",
+		SecretKey: "test-secret-key",
+	}
+
+	if err := validateConfig(testConfig); err != nil {
+		t.Fatalf("Expected no error for disabled config, got %v", err)
+	}
+
+	expectedToken := base64.StdEncoding.EncodeToString([]byte("test-public-key:test-secret-key"))
+	if testConfig.Base64Token != expectedToken {
+		t.Errorf("Expected Base64Token to still be generated, got '%s'", testConfig.Base64Token)
+	}
+}
@@ -0,0 +1,181 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// DatasetRun represents a named run of an experiment over a dataset
+type DatasetRun struct {
+	ID          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	DatasetID   string                 `json:"datasetId,omitempty"`
+	DatasetName string                 `json:"datasetName,omitempty"`
+	CreatedAt   *time.Time             `json:"createdAt,omitempty"`
+	UpdatedAt   *time.Time             `json:"updatedAt,omitempty"`
+}
+
+// DatasetRunWithItems represents a dataset run including its run items
+type DatasetRunWithItems struct {
+	DatasetRun
+	DatasetRunItems []DatasetRunItem `json:"datasetRunItems"`
+}
+
+// DatasetRunList represents a page of dataset runs
+type DatasetRunList struct {
+	Data []DatasetRun `json:"data"`
+	Meta MetaResponse `json:"meta"`
+}
+
+// DatasetRunItem links a dataset item to the trace (and optionally observation)
+// produced for it during a dataset run
+type DatasetRunItem struct {
+	ID             string     `json:"id"`
+	DatasetRunID   string     `json:"datasetRunId"`
+	DatasetRunName string     `json:"datasetRunName,omitempty"`
+	DatasetItemID  string     `json:"datasetItemId"`
+	TraceID        string     `json:"traceId"`
+	ObservationID  string     `json:"observationId,omitempty"`
+	CreatedAt      *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt      *time.Time `json:"updatedAt,omitempty"`
+}
+
+// DatasetRunItemList represents a page of dataset run items
+type DatasetRunItemList struct {
+	Data []DatasetRunItem `json:"data"`
+	Meta MetaResponse     `json:"meta"`
+}
+
+// CreateDatasetRunItemRequest represents the request body for creating a dataset
+// run item. The run is created on first use of RunName.
+type CreateDatasetRunItemRequest struct {
+	RunName        string                 `json:"runName"`
+	RunDescription string                 `json:"runDescription,omitempty"`
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+	DatasetItemID  string                 `json:"datasetItemId"`
+	TraceID        string                 `json:"traceId,omitempty"`
+	ObservationID  string                 `json:"observationId,omitempty"`
+}
+
+// DatasetRunItemFilter holds the query parameters for listing dataset run items.
+// DatasetID and RunName are required by Langfuse.
+type DatasetRunItemFilter struct {
+	DatasetID string
+	RunName   string
+	Page      int
+	Limit     int
+}
+
+// GetRun retrieves a dataset run including its run items
+// https://api.reference.langfuse.com/#tag/datasets/get/api/public/datasets/{datasetName}/runs/{runName}
+func (s *DatasetsService) GetRun(ctx context.Context, datasetName, runName string) (*DatasetRunWithItems, error) {
+	u := fmt.Sprintf("/api/public/datasets/%s/runs/%s", url.PathEscape(datasetName), url.PathEscape(runName))
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching dataset run: %w", err)
+	}
+
+	var run DatasetRunWithItems
+	err = json.Unmarshal(body, &run)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling dataset run data: %w", err)
+	}
+
+	return &run, nil
+}
+
+// ListRuns retrieves a page of runs of a dataset. Zero page and limit use the server defaults.
+// https://api.reference.langfuse.com/#tag/datasets/get/api/public/datasets/{datasetName}/runs
+func (s *DatasetsService) ListRuns(
+	ctx context.Context,
+	datasetName string,
+	page, limit int,
+) (*DatasetRunList, error) {
+	params := url.Values{}
+	setInt(params, "page", page)
+	setInt(params, "limit", limit)
+
+	u := withQuery(fmt.Sprintf("/api/public/datasets/%s/runs", url.PathEscape(datasetName)), params)
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing dataset runs: %w", err)
+	}
+
+	var runs DatasetRunList
+	err = json.Unmarshal(body, &runs)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling dataset runs data: %w", err)
+	}
+
+	return &runs, nil
+}
+
+// DeleteRun deletes a dataset run and all of its run items
+// https://api.reference.langfuse.com/#tag/datasets/delete/api/public/datasets/{datasetName}/runs/{runName}
+func (s *DatasetsService) DeleteRun(ctx context.Context, datasetName, runName string) error {
+	u := fmt.Sprintf("/api/public/datasets/%s/runs/%s", url.PathEscape(datasetName), url.PathEscape(runName))
+
+	_, err := s.client.DoWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting dataset run: %w", err)
+	}
+
+	return nil
+}
+
+// CreateRunItem links a dataset item to a trace within the named dataset run
+// https://api.reference.langfuse.com/#tag/datasetrunitems/post/api/public/dataset-run-items
+func (s *DatasetsService) CreateRunItem(
+	ctx context.Context,
+	request CreateDatasetRunItemRequest,
+) (*DatasetRunItem, error) {
+	u := "/api/public/dataset-run-items"
+
+	body, err := s.client.DoWithContext(ctx, "POST", u, &request)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dataset run item: %w", err)
+	}
+
+	var item DatasetRunItem
+	err = json.Unmarshal(body, &item)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling dataset run item data: %w", err)
+	}
+
+	return &item, nil
+}
+
+// ListRunItems retrieves a page of items of a dataset run
+// https://api.reference.langfuse.com/#tag/datasetrunitems/get/api/public/dataset-run-items
+func (s *DatasetsService) ListRunItems(
+	ctx context.Context,
+	filter DatasetRunItemFilter,
+) (*DatasetRunItemList, error) {
+	params := url.Values{}
+	setString(params, "datasetId", filter.DatasetID)
+	setString(params, "runName", filter.RunName)
+	setInt(params, "page", filter.Page)
+	setInt(params, "limit", filter.Limit)
+
+	u := withQuery("/api/public/dataset-run-items", params)
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing dataset run items: %w", err)
+	}
+
+	var items DatasetRunItemList
+	err = json.Unmarshal(body, &items)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling dataset run items data: %w", err)
+	}
+
+	return &items, nil
+}
@@ -0,0 +1,172 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDatasetsService_GetRun_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("Expected GET method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/api/public/datasets/qa/runs/baseline" {
+			t.Errorf("Expected path /api/public/datasets/qa/runs/baseline, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"id": "run-1",
+			"name": "baseline",
+			"datasetId": "dataset-1",
+			"datasetName": "qa",
+			"datasetRunItems": [
+				{"id": "ri-1", "datasetRunId": "run-1", "datasetItemId": "item-1", "traceId": "trace-1"}
+			]
+		}`))
+	}
+
+	client, server := setupDatasetsTestClient(handler)
+	defer server.Close()
+
+	run, err := client.Datasets.GetRun(context.Background(), "qa", "baseline")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if run.ID != "run-1" || len(run.DatasetRunItems) != 1 || run.DatasetRunItems[0].TraceID != "trace-1" {
+		t.Errorf("Unexpected run %+v", run)
+	}
+}
+
+func TestDatasetsService_ListRuns_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/datasets/qa/runs" {
+			t.Errorf("Expected path /api/public/datasets/qa/runs, got %s", r.URL.Path)
+		}
+
+		if r.URL.Query().Get("limit") != "10" || r.URL.Query().Has("page") {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [{"id": "run-1", "name": "baseline"}, {"id": "run-2", "name": "candidate"}],
+			"meta": {"page": 1, "limit": 10, "totalItems": 2, "totalPages": 1}}`))
+	}
+
+	client, server := setupDatasetsTestClient(handler)
+	defer server.Close()
+
+	runs, err := client.Datasets.ListRuns(context.Background(), "qa", 0, 10)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(runs.Data) != 2 || runs.Data[1].Name != "candidate" {
+		t.Errorf("Unexpected runs %+v", runs.Data)
+	}
+}
+
+func TestDatasetsService_DeleteRun(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Expected DELETE method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/api/public/datasets/qa/runs/baseline" {
+			t.Errorf("Expected path /api/public/datasets/qa/runs/baseline, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message": "Dataset run deleted"}`))
+	}
+
+	client, server := setupDatasetsTestClient(handler)
+	defer server.Close()
+
+	if err := client.Datasets.DeleteRun(context.Background(), "qa", "baseline"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestDatasetsService_CreateRunItem_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/api/public/dataset-run-items" {
+			t.Errorf("Expected path /api/public/dataset-run-items, got %s", r.URL.Path)
+		}
+
+		var request CreateDatasetRunItemRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		expected := CreateDatasetRunItemRequest{
+			RunName:        "baseline",
+			RunDescription: "first run",
+			DatasetItemID:  "item-1",
+			TraceID:        "trace-1",
+			ObservationID:  "obs-1",
+		}
+		if !reflect.DeepEqual(request, expected) {
+			t.Errorf("Expected %+v, got %+v", expected, request)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "ri-1", "datasetRunId": "run-1", "datasetRunName": "baseline",
+			"datasetItemId": "item-1", "traceId": "trace-1", "observationId": "obs-1"}`))
+	}
+
+	client, server := setupDatasetsTestClient(handler)
+	defer server.Close()
+
+	item, err := client.Datasets.CreateRunItem(context.Background(), CreateDatasetRunItemRequest{
+		RunName:        "baseline",
+		RunDescription: "first run",
+		DatasetItemID:  "item-1",
+		TraceID:        "trace-1",
+		ObservationID:  "obs-1",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if item.DatasetRunID != "run-1" || item.ObservationID != "obs-1" {
+		t.Errorf("Unexpected run item %+v", item)
+	}
+}
+
+func TestDatasetsService_ListRunItems_Filter(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("datasetId") != "dataset-1" || query.Get("runName") != "baseline" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [{"id": "ri-1", "datasetRunId": "run-1", "datasetItemId": "item-1", "traceId": "t"}],
+			"meta": {"page": 1, "limit": 50, "totalItems": 1, "totalPages": 1}}`))
+	}
+
+	client, server := setupDatasetsTestClient(handler)
+	defer server.Close()
+
+	items, err := client.Datasets.ListRunItems(context.Background(), DatasetRunItemFilter{
+		DatasetID: "dataset-1",
+		RunName:   "baseline",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(items.Data) != 1 || items.Data[0].DatasetItemID != "item-1" {
+		t.Errorf("Unexpected run items %+v", items.Data)
+	}
+}
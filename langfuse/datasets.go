@@ -0,0 +1,304 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"time"
+)
+
+// DatasetsService handles operations related to datasets, dataset items and dataset runs
+type DatasetsService service
+
+// DatasetItemStatus represents whether a dataset item is used in experiments
+type DatasetItemStatus string
+
+// Dataset item statuses
+const (
+	DatasetItemStatusActive   DatasetItemStatus = "ACTIVE"
+	DatasetItemStatusArchived DatasetItemStatus = "ARCHIVED"
+)
+
+// Dataset represents a collection of dataset items
+type Dataset struct {
+	ID          string                 `json:"id,omitempty"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	ProjectID   string                 `json:"projectId,omitempty"`
+	CreatedAt   *time.Time             `json:"createdAt,omitempty"`
+	UpdatedAt   *time.Time             `json:"updatedAt,omitempty"`
+}
+
+// DatasetList represents a page of datasets
+type DatasetList struct {
+	Data []Dataset    `json:"data"`
+	Meta MetaResponse `json:"meta"`
+}
+
+// DatasetItem represents a single test case of a dataset. SourceTraceID and
+// SourceObservationID link the item to the production data it was created from.
+type DatasetItem struct {
+	ID                  string                 `json:"id,omitempty"`
+	DatasetName         string                 `json:"datasetName,omitempty"`
+	DatasetID           string                 `json:"datasetId,omitempty"`
+	Input               interface{}            `json:"input,omitempty"`
+	ExpectedOutput      interface{}            `json:"expectedOutput,omitempty"`
+	Metadata            map[string]interface{} `json:"metadata,omitempty"`
+	SourceTraceID       string                 `json:"sourceTraceId,omitempty"`
+	SourceObservationID string                 `json:"sourceObservationId,omitempty"`
+	Status              DatasetItemStatus      `json:"status,omitempty"`
+	CreatedAt           *time.Time             `json:"createdAt,omitempty"`
+	UpdatedAt           *time.Time             `json:"updatedAt,omitempty"`
+}
+
+// DatasetItemList represents a page of dataset items
+type DatasetItemList struct {
+	Data []DatasetItem `json:"data"`
+	Meta MetaResponse  `json:"meta"`
+}
+
+// DatasetItemFilter holds the query parameters for listing dataset items. Zero values are omitted.
+type DatasetItemFilter struct {
+	Page                int
+	Limit               int
+	DatasetName         string
+	SourceTraceID       string
+	SourceObservationID string
+}
+
+// CreateDataset creates a new dataset
+// https://api.reference.langfuse.com/#tag/datasets/post/api/public/v2/datasets
+func (s *DatasetsService) CreateDataset(ctx context.Context, dataset Dataset) (*Dataset, error) {
+	u := "/api/public/v2/datasets"
+
+	body, err := s.client.DoWithContext(ctx, "POST", u, &dataset)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dataset: %w", err)
+	}
+
+	var created Dataset
+	err = json.Unmarshal(body, &created)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling created dataset data: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetDataset retrieves a dataset by its name
+// https://api.reference.langfuse.com/#tag/datasets/get/api/public/v2/datasets/{datasetName}
+func (s *DatasetsService) GetDataset(ctx context.Context, name string) (*Dataset, error) {
+	u := fmt.Sprintf("/api/public/v2/datasets/%s", url.PathEscape(name))
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching dataset: %w", err)
+	}
+
+	var dataset Dataset
+	err = json.Unmarshal(body, &dataset)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling dataset data: %w", err)
+	}
+
+	return &dataset, nil
+}
+
+// ListDatasets retrieves a page of datasets. Zero page and limit use the server defaults.
+// https://api.reference.langfuse.com/#tag/datasets/get/api/public/v2/datasets
+func (s *DatasetsService) ListDatasets(ctx context.Context, page, limit int) (*DatasetList, error) {
+	params := url.Values{}
+	setInt(params, "page", page)
+	setInt(params, "limit", limit)
+
+	u := withQuery("/api/public/v2/datasets", params)
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing datasets: %w", err)
+	}
+
+	var datasets DatasetList
+	err = json.Unmarshal(body, &datasets)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling datasets data: %w", err)
+	}
+
+	return &datasets, nil
+}
+
+// AllDatasets iterates over all datasets, fetching pages as needed
+func (s *DatasetsService) AllDatasets(ctx context.Context) iter.Seq2[Dataset, error] {
+	return paginate(ctx, 1, func(ctx context.Context, page int) ([]Dataset, *MetaResponse, error) {
+		datasets, err := s.ListDatasets(ctx, page, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		return datasets.Data, &datasets.Meta, nil
+	})
+}
+
+// CreateItem creates a new item in the dataset named by item.DatasetName
+// https://api.reference.langfuse.com/#tag/datasetitems/post/api/public/dataset-items
+func (s *DatasetsService) CreateItem(ctx context.Context, item DatasetItem) (*DatasetItem, error) {
+	if item.DatasetName == "" {
+		return nil, fmt.Errorf("error creating dataset item: dataset name is required")
+	}
+
+	created, err := s.postItem(ctx, &item)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dataset item: %w", err)
+	}
+
+	return created, nil
+}
+
+// UpsertItem creates the item or, if an item with item.ID already exists, replaces it
+// https://api.reference.langfuse.com/#tag/datasetitems/post/api/public/dataset-items
+func (s *DatasetsService) UpsertItem(ctx context.Context, item DatasetItem) (*DatasetItem, error) {
+	if item.ID == "" {
+		return nil, fmt.Errorf("error upserting dataset item: item ID is required")
+	}
+	if item.DatasetName == "" {
+		return nil, fmt.Errorf("error upserting dataset item: dataset name is required")
+	}
+
+	upserted, err := s.postItem(ctx, &item)
+	if err != nil {
+		return nil, fmt.Errorf("error upserting dataset item: %w", err)
+	}
+
+	return upserted, nil
+}
+
+// GetItem retrieves a single dataset item
+// https://api.reference.langfuse.com/#tag/datasetitems/get/api/public/dataset-items/{id}
+func (s *DatasetsService) GetItem(ctx context.Context, id string) (*DatasetItem, error) {
+	u := fmt.Sprintf("/api/public/dataset-items/%s", url.PathEscape(id))
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching dataset item: %w", err)
+	}
+
+	var item DatasetItem
+	err = json.Unmarshal(body, &item)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling dataset item data: %w", err)
+	}
+
+	return &item, nil
+}
+
+// ListItems retrieves a page of dataset items matching the filter
+// https://api.reference.langfuse.com/#tag/datasetitems/get/api/public/dataset-items
+func (s *DatasetsService) ListItems(ctx context.Context, filter DatasetItemFilter) (*DatasetItemList, error) {
+	params := url.Values{}
+	setInt(params, "page", filter.Page)
+	setInt(params, "limit", filter.Limit)
+	setString(params, "datasetName", filter.DatasetName)
+	setString(params, "sourceTraceId", filter.SourceTraceID)
+	setString(params, "sourceObservationId", filter.SourceObservationID)
+
+	u := withQuery("/api/public/dataset-items", params)
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing dataset items: %w", err)
+	}
+
+	var items DatasetItemList
+	err = json.Unmarshal(body, &items)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling dataset items data: %w", err)
+	}
+
+	return &items, nil
+}
+
+// AllItems iterates over all dataset items matching the filter, fetching pages
+// as needed starting at filter.Page
+func (s *DatasetsService) AllItems(ctx context.Context, filter DatasetItemFilter) iter.Seq2[DatasetItem, error] {
+	return paginate(ctx, filter.Page, func(ctx context.Context, page int) ([]DatasetItem, *MetaResponse, error) {
+		filter.Page = page
+		items, err := s.ListItems(ctx, filter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return items.Data, &items.Meta, nil
+	})
+}
+
+// DeleteItem deletes a single dataset item
+// https://api.reference.langfuse.com/#tag/datasetitems/delete/api/public/dataset-items/{id}
+func (s *DatasetsService) DeleteItem(ctx context.Context, id string) error {
+	u := fmt.Sprintf("/api/public/dataset-items/%s", url.PathEscape(id))
+
+	_, err := s.client.DoWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting dataset item: %w", err)
+	}
+
+	return nil
+}
+
+// CreateItemFromTrace fetches a trace and adds its input to the named dataset as a new
+// item linked to the trace. expectedOutput is optional and typically holds the corrected answer.
+func (s *DatasetsService) CreateItemFromTrace(
+	ctx context.Context,
+	datasetName, traceID string,
+	expectedOutput interface{},
+) (*DatasetItem, error) {
+	trace, err := s.client.Traces.Get(ctx, traceID)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dataset item from trace: %w", err)
+	}
+
+	return s.CreateItem(ctx, DatasetItem{
+		DatasetName:    datasetName,
+		Input:          trace.Input,
+		ExpectedOutput: expectedOutput,
+		SourceTraceID:  trace.ID,
+	})
+}
+
+// CreateItemFromObservation fetches an observation and adds its input to the named dataset
+// as a new item linked to the observation and its trace. expectedOutput is optional.
+func (s *DatasetsService) CreateItemFromObservation(
+	ctx context.Context,
+	datasetName, observationID string,
+	expectedOutput interface{},
+) (*DatasetItem, error) {
+	observation, err := s.client.Observations.Get(ctx, observationID)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dataset item from observation: %w", err)
+	}
+
+	return s.CreateItem(ctx, DatasetItem{
+		DatasetName:         datasetName,
+		Input:               observation.Input,
+		ExpectedOutput:      expectedOutput,
+		SourceTraceID:       observation.TraceID,
+		SourceObservationID: observation.ID,
+	})
+}
+
+func (s *DatasetsService) postItem(ctx context.Context, item *DatasetItem) (*DatasetItem, error) {
+	u := "/api/public/dataset-items"
+
+	body, err := s.client.DoWithContext(ctx, "POST", u, item)
+	if err != nil {
+		return nil, err
+	}
+
+	var saved DatasetItem
+	err = json.Unmarshal(body, &saved)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling dataset item data: %w", err)
+	}
+
+	return &saved, nil
+}
@@ -0,0 +1,449 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupDatasetsTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.Datasets = (*DatasetsService)(&service{client: client})
+	client.Traces = (*TracesService)(&service{client: client})
+	client.Observations = (*ObservationsService)(&service{client: client})
+
+	return client, server
+}
+
+func TestDatasetsService_CreateDataset_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/api/public/v2/datasets" {
+			t.Errorf("Expected path /api/public/v2/datasets, got %s", r.URL.Path)
+		}
+
+		var dataset Dataset
+		if err := json.NewDecoder(r.Body).Decode(&dataset); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		if dataset.Name != "qa" || dataset.Description != "QA pairs" {
+			t.Errorf("Unexpected dataset %+v", dataset)
+		}
+
+		dataset.ID = "dataset-1"
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(dataset)
+	}
+
+	client, server := setupDatasetsTestClient(handler)
+	defer server.Close()
+
+	dataset, err := client.Datasets.CreateDataset(context.Background(), Dataset{Name: "qa", Description: "QA pairs"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if dataset.ID != "dataset-1" {
+		t.Errorf("Expected ID dataset-1, got %s", dataset.ID)
+	}
+}
+
+func TestDatasetsService_GetDataset_EscapesName(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/public/v2/datasets/evals%2Fqa" {
+			t.Errorf("Expected escaped dataset name in path, got %s", r.URL.EscapedPath())
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "dataset-1", "name": "evals/qa", "createdAt": "2024-01-01T00:00:00Z"}`))
+	}
+
+	client, server := setupDatasetsTestClient(handler)
+	defer server.Close()
+
+	dataset, err := client.Datasets.GetDataset(context.Background(), "evals/qa")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if dataset.Name != "evals/qa" || dataset.CreatedAt == nil {
+		t.Errorf("Unexpected dataset %+v", dataset)
+	}
+}
+
+func TestDatasetsService_AllDatasets_Paginates(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{
+			"data": [{"id": "dataset-%s", "name": "d%s"}],
+			"meta": {"page": %s, "limit": 1, "totalItems": 2, "totalPages": 2}
+		}`, page, page, page)
+	}
+
+	client, server := setupDatasetsTestClient(handler)
+	defer server.Close()
+
+	var ids []string
+	for dataset, err := range client.Datasets.AllDatasets(context.Background()) {
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		ids = append(ids, dataset.ID)
+	}
+
+	if !reflect.DeepEqual(ids, []string{"dataset-1", "dataset-2"}) {
+		t.Errorf("Expected [dataset-1 dataset-2], got %v", ids)
+	}
+}
+
+func TestDatasetsService_CreateItem_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/api/public/dataset-items" {
+			t.Errorf("Expected path /api/public/dataset-items, got %s", r.URL.Path)
+		}
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		expected := map[string]interface{}{
+			"datasetName":    "qa",
+			"input":          "What is 2+2?",
+			"expectedOutput": "4",
+			"sourceTraceId":  "trace-1",
+			"status":         "ACTIVE",
+		}
+		for key, value := range expected {
+			if payload[key] != value {
+				t.Errorf("Expected %s=%v, got %v", key, value, payload[key])
+			}
+		}
+		if _, ok := payload["id"]; ok {
+			t.Errorf("Expected no id for a new item, got %v", payload["id"])
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "item-1", "datasetId": "dataset-1", "datasetName": "qa", "status": "ACTIVE"}`))
+	}
+
+	client, server := setupDatasetsTestClient(handler)
+	defer server.Close()
+
+	item, err := client.Datasets.CreateItem(context.Background(), DatasetItem{
+		DatasetName:    "qa",
+		Input:          "What is 2+2?",
+		ExpectedOutput: "4",
+		SourceTraceID:  "trace-1",
+		Status:         DatasetItemStatusActive,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if item.ID != "item-1" || item.DatasetID != "dataset-1" {
+		t.Errorf("Unexpected item %+v", item)
+	}
+}
+
+func TestDatasetsService_Items_RequiredFields(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request for invalid item")
+	}
+
+	client, server := setupDatasetsTestClient(handler)
+	defer server.Close()
+
+	tests := []struct {
+		name          string
+		call          func() error
+		expectedError string
+	}{
+		{
+			name: "create without dataset",
+			call: func() error {
+				_, err := client.Datasets.CreateItem(context.Background(), DatasetItem{Input: "x"})
+				return err
+			},
+			expectedError: "error creating dataset item: dataset name is required",
+		},
+		{
+			name: "upsert without ID",
+			call: func() error {
+				_, err := client.Datasets.UpsertItem(context.Background(), DatasetItem{DatasetName: "qa"})
+				return err
+			},
+			expectedError: "error upserting dataset item: item ID is required",
+		},
+		{
+			name: "upsert without dataset",
+			call: func() error {
+				_, err := client.Datasets.UpsertItem(context.Background(), DatasetItem{ID: "item-1"})
+				return err
+			},
+			expectedError: "error upserting dataset item: dataset name is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if err == nil {
+				t.Fatal("Expected error, got nil")
+			}
+
+			if err.Error() != tt.expectedError {
+				t.Errorf("Expected error '%s', got '%s'", tt.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestDatasetsService_UpsertItem_SendsID(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		var item DatasetItem
+		if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		if item.ID != "item-1" || item.Status != DatasetItemStatusArchived {
+			t.Errorf("Unexpected item %+v", item)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(item)
+	}
+
+	client, server := setupDatasetsTestClient(handler)
+	defer server.Close()
+
+	item, err := client.Datasets.UpsertItem(context.Background(), DatasetItem{
+		ID:          "item-1",
+		DatasetName: "qa",
+		Status:      DatasetItemStatusArchived,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if item.Status != DatasetItemStatusArchived {
+		t.Errorf("Expected status ARCHIVED, got %s", item.Status)
+	}
+}
+
+func TestDatasetsService_ListItems_Filter(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/dataset-items" {
+			t.Errorf("Expected path /api/public/dataset-items, got %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		expected := map[string][]string{
+			"datasetName":         {"qa"},
+			"sourceTraceId":       {"trace-1"},
+			"sourceObservationId": {"obs-1"},
+			"page":                {"2"},
+			"limit":               {"5"},
+		}
+		for key, values := range expected {
+			if !reflect.DeepEqual(query[key], values) {
+				t.Errorf("Expected %s=%v, got %v", key, values, query[key])
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [{"id": "item-1", "datasetName": "qa", "input": {"question": "hi"}}],
+			"meta": {"page": 2, "limit": 5, "totalItems": 6, "totalPages": 2}}`))
+	}
+
+	client, server := setupDatasetsTestClient(handler)
+	defer server.Close()
+
+	items, err := client.Datasets.ListItems(context.Background(), DatasetItemFilter{
+		Page:                2,
+		Limit:               5,
+		DatasetName:         "qa",
+		SourceTraceID:       "trace-1",
+		SourceObservationID: "obs-1",
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(items.Data) != 1 || items.Meta.TotalItems != 6 {
+		t.Errorf("Unexpected items %+v", items)
+	}
+}
+
+func TestDatasetsService_GetItem_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/dataset-items/item-1" {
+			t.Errorf("Expected path /api/public/dataset-items/item-1, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "item-1", "datasetName": "qa", "sourceObservationId": "obs-1", "status": "ARCHIVED"}`))
+	}
+
+	client, server := setupDatasetsTestClient(handler)
+	defer server.Close()
+
+	item, err := client.Datasets.GetItem(context.Background(), "item-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if item.SourceObservationID != "obs-1" || item.Status != DatasetItemStatusArchived {
+		t.Errorf("Unexpected item %+v", item)
+	}
+}
+
+func TestDatasetsService_DeleteItem_Error(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Expected DELETE method, got %s", r.Method)
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("not found"))
+	}
+
+	client, server := setupDatasetsTestClient(handler)
+	defer server.Close()
+
+	err := client.Datasets.DeleteItem(context.Background(), "missing")
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error deleting dataset item: client error 404: not found"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
+
+func TestDatasetsService_CreateItemFromTrace(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/public/traces/trace-1":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id": "trace-1", "input": {"question": "capital of Australia?"}, "output": "Sydney"}`))
+		case "/api/public/dataset-items":
+			var item DatasetItem
+			if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+
+			input, _ := item.Input.(map[string]interface{})
+			if item.DatasetName != "regressions" || input["question"] != "capital of Australia?" {
+				t.Errorf("Unexpected item %+v", item)
+			}
+			if item.ExpectedOutput != "Canberra" || item.SourceTraceID != "trace-1" || item.SourceObservationID != "" {
+				t.Errorf("Unexpected item links %+v", item)
+			}
+
+			item.ID = "item-1"
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(item)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+
+	client, server := setupDatasetsTestClient(handler)
+	defer server.Close()
+
+	item, err := client.Datasets.CreateItemFromTrace(context.Background(), "regressions", "trace-1", "Canberra")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if item.ID != "item-1" {
+		t.Errorf("Expected ID item-1, got %s", item.ID)
+	}
+}
+
+func TestDatasetsService_CreateItemFromObservation(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/public/observations/obs-1":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id": "obs-1", "traceId": "trace-1", "type": "GENERATION", "input": "2+2"}`))
+		case "/api/public/dataset-items":
+			var item DatasetItem
+			if err := json.NewDecoder(r.Body).Decode(&item); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+
+			if item.Input != "2+2" || item.ExpectedOutput != nil {
+				t.Errorf("Unexpected item %+v", item)
+			}
+			if item.SourceTraceID != "trace-1" || item.SourceObservationID != "obs-1" {
+				t.Errorf("Unexpected item links %+v", item)
+			}
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(item)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+
+	client, server := setupDatasetsTestClient(handler)
+	defer server.Close()
+
+	_, err := client.Datasets.CreateItemFromObservation(context.Background(), "regressions", "obs-1", nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestDatasetsService_CreateItemFromTrace_Error(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("not found"))
+	}
+
+	client, server := setupDatasetsTestClient(handler)
+	defer server.Close()
+
+	_, err := client.Datasets.CreateItemFromTrace(context.Background(), "regressions", "missing", nil)
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error creating dataset item from trace: error fetching trace: client error 404: not found"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
@@ -0,0 +1,257 @@
+package langfuse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ExperimentTask runs the application under test on a single dataset item and returns its output
+type ExperimentTask func(ctx context.Context, item DatasetItem) (interface{}, error)
+
+// ExperimentEvaluator scores the output produced for a dataset item. The returned
+// scores are attached to the item's trace: the runner sets TraceID and clears
+// SessionID and DatasetRunID, as a score targets exactly one of them.
+type ExperimentEvaluator func(ctx context.Context, item DatasetItem, output interface{}) ([]Score, error)
+
+// ExperimentRunEvaluator scores a whole experiment run from the results of all
+// items, e.g. to compute aggregate metrics. The returned scores are attached to
+// the dataset run only: the runner sets DatasetRunID and clears the other targets.
+type ExperimentRunEvaluator func(ctx context.Context, items []ExperimentItemResult) ([]Score, error)
+
+// ExperimentOptions configures a dataset experiment run
+type ExperimentOptions struct {
+	DatasetName    string
+	RunName        string
+	RunDescription string
+	Metadata       map[string]interface{}
+	// Concurrency is the number of items processed in parallel. Values below 1 run items sequentially.
+	Concurrency   int
+	Task          ExperimentTask
+	Evaluators    []ExperimentEvaluator
+	RunEvaluators []ExperimentRunEvaluator
+}
+
+// ExperimentItemResult holds the outcome of running the task on a single dataset item.
+// Err is set when the task, the trace, the run item link or an evaluator failed.
+type ExperimentItemResult struct {
+	Item         DatasetItem
+	TraceID      string
+	DatasetRunID string
+	Output       interface{}
+	Scores       []Score
+	Err          error
+}
+
+// ExperimentResult summarises a dataset experiment run. Items are in dataset order.
+// RunErr is set when a run evaluator or one of its scores failed.
+type ExperimentResult struct {
+	DatasetName  string
+	RunName      string
+	DatasetRunID string
+	Items        []ExperimentItemResult
+	RunScores    []Score
+	Succeeded    int
+	Failed       int
+	RunErr       error
+}
+
+// Errors returns the errors of all failed items and of the run evaluators
+func (r *ExperimentResult) Errors() []error {
+	var errs []error
+	for _, item := range r.Items {
+		if item.Err != nil {
+			errs = append(errs, fmt.Errorf("dataset item %s: %w", item.Item.ID, item.Err))
+		}
+	}
+	if r.RunErr != nil {
+		errs = append(errs, fmt.Errorf("dataset run: %w", r.RunErr))
+	}
+	return errs
+}
+
+// RunExperiment runs opts.Task on every active item of the dataset, records a
+// trace per item, links it to the dataset run opts.RunName and applies the
+// evaluators to the output. Once all items are done, the run evaluators score the
+// run as a whole. Per-item failures are reported in the result rather
+// than aborting the run; an error is returned only if the options are invalid
+// or the dataset items cannot be listed.
+//
+// Traces are sent through the ingestion service without sampling, so every item
+// is recorded regardless of the client's sample rate; the mask function applies.
+func (s *DatasetsService) RunExperiment(ctx context.Context, opts ExperimentOptions) (*ExperimentResult, error) {
+	if opts.DatasetName == "" {
+		return nil, errors.New("error running experiment: dataset name is required")
+	}
+	if opts.RunName == "" {
+		return nil, errors.New("error running experiment: run name is required")
+	}
+	if opts.Task == nil {
+		return nil, errors.New("error running experiment: task is required")
+	}
+
+	var items []DatasetItem
+	for item, err := range s.AllItems(ctx, DatasetItemFilter{DatasetName: opts.DatasetName}) {
+		if err != nil {
+			return nil, fmt.Errorf("error running experiment: %w", err)
+		}
+		if item.Status == DatasetItemStatusArchived {
+			continue
+		}
+		items = append(items, item)
+	}
+
+	concurrency := max(opts.Concurrency, 1)
+
+	result := &ExperimentResult{
+		DatasetName: opts.DatasetName,
+		RunName:     opts.RunName,
+		Items:       make([]ExperimentItemResult, len(items)),
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, concurrency)
+	for i, item := range items {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			result.Items[i] = s.runExperimentItem(ctx, &opts, item)
+		}()
+	}
+	wg.Wait()
+
+	for _, item := range result.Items {
+		if item.Err != nil {
+			result.Failed++
+		} else {
+			result.Succeeded++
+		}
+		if result.DatasetRunID == "" {
+			result.DatasetRunID = item.DatasetRunID
+		}
+	}
+
+	result.RunErr = s.runExperimentRunEvaluators(ctx, &opts, result)
+
+	return result, nil
+}
+
+func (s *DatasetsService) runExperimentItem(
+	ctx context.Context,
+	opts *ExperimentOptions,
+	item DatasetItem,
+) ExperimentItemResult {
+	result := ExperimentItemResult{Item: item, TraceID: newID()}
+
+	start := time.Now().UTC()
+	output, taskErr := opts.Task(ctx, item)
+	result.Output = output
+
+	metadata := map[string]interface{}{
+		"datasetName":   opts.DatasetName,
+		"datasetItemId": item.ID,
+		"runName":       opts.RunName,
+	}
+	if taskErr != nil {
+		metadata["error"] = taskErr.Error()
+	}
+
+	trace := &TraceBody{
+		ID:        result.TraceID,
+		Timestamp: &start,
+		Name:      opts.RunName,
+		Input:     item.Input,
+		Output:    output,
+		Metadata:  metadata,
+	}
+	response, err := s.client.Ingestion.Batch(withoutSampling(ctx), []*IngestionEvent{NewTraceCreateEvent(trace)})
+	if err == nil && len(response.Errors) > 0 {
+		err = fmt.Errorf("ingestion rejected trace: %s", response.Errors[0].Message)
+	}
+	if err != nil {
+		result.Err = fmt.Errorf("error recording experiment trace: %w", err)
+		return result
+	}
+
+	runItem, err := s.CreateRunItem(ctx, CreateDatasetRunItemRequest{
+		RunName:        opts.RunName,
+		RunDescription: opts.RunDescription,
+		Metadata:       opts.Metadata,
+		DatasetItemID:  item.ID,
+		TraceID:        result.TraceID,
+	})
+	if err != nil {
+		result.Err = err
+		return result
+	}
+	result.DatasetRunID = runItem.DatasetRunID
+
+	if taskErr != nil {
+		result.Err = fmt.Errorf("error running experiment task: %w", taskErr)
+		return result
+	}
+
+	for _, evaluate := range opts.Evaluators {
+		scores, err := evaluate(ctx, item, output)
+		if err != nil {
+			result.Err = fmt.Errorf("error running experiment evaluator: %w", err)
+			return result
+		}
+
+		for _, score := range scores {
+			score.TraceID = result.TraceID
+			score.SessionID = ""
+			score.DatasetRunID = ""
+
+			created, err := s.client.Scores.Create(ctx, score)
+			if err != nil {
+				result.Err = err
+				return result
+			}
+			result.Scores = append(result.Scores, *created)
+		}
+	}
+
+	return result
+}
+
+// runExperimentRunEvaluators applies the run evaluators to the item results and
+// attaches their scores to the dataset run
+func (s *DatasetsService) runExperimentRunEvaluators(
+	ctx context.Context,
+	opts *ExperimentOptions,
+	result *ExperimentResult,
+) error {
+	if len(opts.RunEvaluators) == 0 {
+		return nil
+	}
+	if result.DatasetRunID == "" {
+		return errors.New("error running experiment run evaluator: no dataset run was created")
+	}
+
+	for _, evaluate := range opts.RunEvaluators {
+		scores, err := evaluate(ctx, result.Items)
+		if err != nil {
+			return fmt.Errorf("error running experiment run evaluator: %w", err)
+		}
+
+		for _, score := range scores {
+			score.DatasetRunID = result.DatasetRunID
+			score.TraceID = ""
+			score.ObservationID = ""
+			score.SessionID = ""
+
+			created, err := s.client.Scores.Create(ctx, score)
+			if err != nil {
+				return err
+			}
+			result.RunScores = append(result.RunScores, *created)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupExperimentsTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.Datasets = (*DatasetsService)(&service{client: client})
+	client.Ingestion = (*IngestionService)(&service{client: client})
+	client.Scores = (*ScoresService)(&service{client: client})
+
+	return client, server
+}
+
+func TestDatasetsService_RunExperiment(t *testing.T) {
+	var mu sync.Mutex
+	traces := map[string]map[string]interface{}{}
+	runItems := map[string]CreateDatasetRunItemRequest{}
+	var scores []Score
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.URL.Path {
+		case "/api/public/dataset-items":
+			if r.URL.Query().Get("datasetName") != "qa" {
+				t.Errorf("Expected datasetName qa, got %s", r.URL.RawQuery)
+			}
+			w.Write([]byte(`{"data": [
+				{"id": "item-1", "input": "2+2", "expectedOutput": "4", "status": "ACTIVE"},
+				{"id": "item-2", "input": "fail", "status": "ACTIVE"},
+				{"id": "item-3", "input": "old", "status": "ARCHIVED"}
+			], "meta": {"page": 1, "limit": 50, "totalItems": 3, "totalPages": 1}}`))
+		case "/api/public/ingestion":
+			for _, event := range decodeIngestionBatch(t, r) {
+				body := event["body"].(map[string]interface{})
+				traces[body["id"].(string)] = body
+			}
+			w.Write([]byte(`{"successes": [], "errors": []}`))
+		case "/api/public/dataset-run-items":
+			var request CreateDatasetRunItemRequest
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				t.Fatalf("Failed to decode run item: %v", err)
+			}
+			runItems[request.DatasetItemID] = request
+			w.Write([]byte(`{"id": "ri", "datasetRunId": "run-1", "datasetItemId": "` + request.DatasetItemID +
+				`", "traceId": "` + request.TraceID + `"}`))
+		case "/api/public/scores":
+			var score Score
+			if err := json.NewDecoder(r.Body).Decode(&score); err != nil {
+				t.Fatalf("Failed to decode score: %v", err)
+			}
+			scores = append(scores, score)
+			w.Write([]byte(`{"id": "score-1"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+
+	client, server := setupExperimentsTestClient(handler)
+	defer server.Close()
+
+	result, err := client.Datasets.RunExperiment(context.Background(), ExperimentOptions{
+		DatasetName:    "qa",
+		RunName:        "baseline",
+		RunDescription: "first run",
+		Concurrency:    2,
+		Task: func(ctx context.Context, item DatasetItem) (interface{}, error) {
+			if item.Input == "fail" {
+				return nil, errors.New("model unavailable")
+			}
+			return "4", nil
+		},
+		Evaluators: []ExperimentEvaluator{
+			func(ctx context.Context, item DatasetItem, output interface{}) ([]Score, error) {
+				exact := 0
+				if output == item.ExpectedOutput {
+					exact = 1
+				}
+				return []Score{{Name: "exact_match", Value: exact, DatasetRunID: "ignored"}}, nil
+			},
+		},
+		RunEvaluators: []ExperimentRunEvaluator{
+			func(ctx context.Context, items []ExperimentItemResult) ([]Score, error) {
+				succeeded := 0
+				for _, item := range items {
+					if item.Err == nil {
+						succeeded++
+					}
+				}
+				return []Score{{Name: "success_rate", Value: float64(succeeded) / float64(len(items))}}, nil
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(result.Items) != 2 || result.Succeeded != 1 || result.Failed != 1 {
+		t.Fatalf("Unexpected result %+v", result)
+	}
+
+	if errs := result.Errors(); len(errs) != 1 ||
+		errs[0].Error() != "dataset item item-2: error running experiment task: model unavailable" {
+		t.Errorf("Unexpected errors %v", errs)
+	}
+
+	for _, item := range result.Items {
+		trace, ok := traces[item.TraceID]
+		if !ok {
+			t.Errorf("Expected trace for item %s", item.Item.ID)
+			continue
+		}
+		if trace["name"] != "baseline" || trace["input"] != item.Item.Input {
+			t.Errorf("Unexpected trace %v", trace)
+		}
+
+		runItem := runItems[item.Item.ID]
+		if runItem.TraceID != item.TraceID || runItem.RunName != "baseline" || runItem.RunDescription != "first run" {
+			t.Errorf("Unexpected run item %+v", runItem)
+		}
+	}
+
+	if _, ok := runItems["item-3"]; ok {
+		t.Error("Expected archived item to be skipped")
+	}
+
+	if len(scores) != 2 {
+		t.Fatalf("Expected 2 scores, got %d", len(scores))
+	}
+
+	// Item scores target the item's trace only
+	if scores[0].TraceID != result.Items[0].TraceID || scores[0].DatasetRunID != "" || scores[0].Value != 1.0 {
+		t.Errorf("Unexpected item score %+v", scores[0])
+	}
+	if len(result.Items[0].Scores) != 1 || result.Items[0].Scores[0].ID != "score-1" {
+		t.Errorf("Expected created score on item result, got %+v", result.Items[0].Scores)
+	}
+
+	// Run scores target the dataset run only
+	if scores[1].Name != "success_rate" || scores[1].DatasetRunID != "run-1" || scores[1].TraceID != "" ||
+		scores[1].Value != 0.5 {
+		t.Errorf("Unexpected run score %+v", scores[1])
+	}
+	if result.DatasetRunID != "run-1" || len(result.RunScores) != 1 || result.RunErr != nil {
+		t.Errorf("Unexpected run result %+v", result)
+	}
+}
+
+func TestDatasetsService_RunExperiment_InvalidOptions(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request for invalid options")
+	}
+
+	client, server := setupExperimentsTestClient(handler)
+	defer server.Close()
+
+	task := func(ctx context.Context, item DatasetItem) (interface{}, error) { return nil, nil }
+
+	tests := []struct {
+		name          string
+		opts          ExperimentOptions
+		expectedError string
+	}{
+		{"missing dataset", ExperimentOptions{RunName: "r", Task: task}, "error running experiment: dataset name is required"},
+		{"missing run", ExperimentOptions{DatasetName: "d", Task: task}, "error running experiment: run name is required"},
+		{"missing task", ExperimentOptions{DatasetName: "d", RunName: "r"}, "error running experiment: task is required"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.Datasets.RunExperiment(context.Background(), tt.opts)
+			if err == nil || err.Error() != tt.expectedError {
+				t.Errorf("Expected error '%s', got '%v'", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestDatasetsService_RunExperiment_RunEvaluatorWithoutRun(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [], "meta": {"page": 1, "limit": 50, "totalItems": 0, "totalPages": 0}}`))
+	}
+
+	client, server := setupExperimentsTestClient(handler)
+	defer server.Close()
+
+	result, err := client.Datasets.RunExperiment(context.Background(), ExperimentOptions{
+		DatasetName: "empty",
+		RunName:     "baseline",
+		Task:        func(ctx context.Context, item DatasetItem) (interface{}, error) { return nil, nil },
+		RunEvaluators: []ExperimentRunEvaluator{
+			func(ctx context.Context, items []ExperimentItemResult) ([]Score, error) {
+				t.Error("Expected run evaluator not to be called without a dataset run")
+				return nil, nil
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if errs := result.Errors(); len(errs) != 1 ||
+		errs[0].Error() != "dataset run: error running experiment run evaluator: no dataset run was created" {
+		t.Errorf("Unexpected errors %v", errs)
+	}
+}
+
+func TestDatasetsService_RunExperiment_IgnoresSampleRate(t *testing.T) {
+	var mu sync.Mutex
+	traces := map[string]bool{}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.URL.Path {
+		case "/api/public/dataset-items":
+			w.Write([]byte(`{"data": [
+				{"id": "item-1", "input": "a", "status": "ACTIVE"},
+				{"id": "item-2", "input": "b", "status": "ACTIVE"}
+			], "meta": {"page": 1, "limit": 50, "totalItems": 2, "totalPages": 1}}`))
+		case "/api/public/ingestion":
+			for _, event := range decodeIngestionBatch(t, r) {
+				body := event["body"].(map[string]interface{})
+				traces[body["id"].(string)] = true
+			}
+			w.Write([]byte(`{"successes": [], "errors": []}`))
+		case "/api/public/dataset-run-items":
+			w.Write([]byte(`{"id": "ri", "datasetRunId": "run-1"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+
+	client, server := setupExperimentsTestClient(handler)
+	defer server.Close()
+
+	rate := 0.0
+	client.sampleRate = &rate
+
+	result, err := client.Datasets.RunExperiment(context.Background(), ExperimentOptions{
+		DatasetName: "qa",
+		RunName:     "sampled",
+		Task:        func(ctx context.Context, item DatasetItem) (interface{}, error) { return item.Input, nil },
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.Succeeded != 2 {
+		t.Fatalf("Unexpected result %+v", result)
+	}
+	for _, item := range result.Items {
+		if !traces[item.TraceID] {
+			t.Errorf("Expected trace for item %s despite a sample rate of 0", item.Item.ID)
+		}
+	}
+}
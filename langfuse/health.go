@@ -0,0 +1,82 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// HealthService handles checks of the Langfuse server's health
+type HealthService service
+
+// HealthStatus represents the health of the Langfuse server. Latency is the
+// round-trip time of the check's single request as measured by the client.
+type HealthStatus struct {
+	Status  string        `json:"status"`
+	Version string        `json:"version"`
+	Latency time.Duration `json:"-"`
+}
+
+// PingResult represents a successful authenticated round trip to Langfuse
+type PingResult struct {
+	ProjectID   string
+	ProjectName string
+	Latency     time.Duration
+}
+
+// Check reports the health of the Langfuse server and its database. An unhealthy
+// server responds with an error status, which is returned as an error. The
+// request is sent once, without retries.
+// https://api.reference.langfuse.com/#tag/health/get/api/public/health
+func (s *HealthService) Check(ctx context.Context) (*HealthStatus, error) {
+	start := time.Now()
+
+	body, err := s.client.DoWithContext(withoutRetries(ctx), "GET", "/api/public/health", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error checking health: %w", err)
+	}
+
+	var status HealthStatus
+	err = json.Unmarshal(body, &status)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling health data: %w", err)
+	}
+
+	status.Latency = time.Since(start)
+
+	return &status, nil
+}
+
+// Ping verifies connectivity and credentials by fetching the project of the API
+// key. Unlike HealthService.Check, it fails on invalid keys, which makes it
+// suitable for readiness probes. Like Check, it sends a single request without
+// retries.
+// https://api.reference.langfuse.com/#tag/projects/get/api/public/projects
+func (c *Client) Ping(ctx context.Context) (*PingResult, error) {
+	start := time.Now()
+
+	body, err := c.DoWithContext(withoutRetries(ctx), "GET", "/api/public/projects", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error pinging langfuse: %w", err)
+	}
+
+	latency := time.Since(start)
+
+	var projects projectList
+	err = json.Unmarshal(body, &projects)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling project data: %w", err)
+	}
+
+	if len(projects.Data) == 0 {
+		return nil, errors.New("error pinging langfuse: no project found for API key")
+	}
+
+	return &PingResult{
+		ProjectID:   projects.Data[0].ID,
+		ProjectName: projects.Data[0].Name,
+		Latency:     latency,
+	}, nil
+}
@@ -0,0 +1,174 @@
+package langfuse
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupHealthTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.Health = (*HealthService)(&service{client: client})
+
+	return client, server
+}
+
+func TestHealthService_Check_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/health" {
+			t.Errorf("Expected path /api/public/health, got %s", r.URL.Path)
+		}
+
+		time.Sleep(5 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status": "OK", "version": "3.50.0"}`))
+	}
+
+	client, server := setupHealthTestClient(handler)
+	defer server.Close()
+
+	status, err := client.Health.Check(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if status.Status != "OK" || status.Version != "3.50.0" {
+		t.Errorf("Unexpected status %+v", status)
+	}
+
+	if status.Latency < 5*time.Millisecond {
+		t.Errorf("Expected latency of at least 5ms, got %v", status.Latency)
+	}
+}
+
+func TestHealthService_Check_Unhealthy(t *testing.T) {
+	requests := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"status": "Database not available"}`))
+	}
+
+	client, server := setupHealthTestClient(handler)
+	defer server.Close()
+
+	_, err := client.Health.Check(context.Background())
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := `error checking health: server error 503: {"status": "Database not available"}`
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected a single attempt, got %d requests", requests)
+	}
+}
+
+func TestClient_Ping_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/projects" {
+			t.Errorf("Expected path /api/public/projects, got %s", r.URL.Path)
+		}
+
+		if r.Header.Get("Authorization") != "Basic test-token" {
+			t.Errorf("Expected Authorization header, got %s", r.Header.Get("Authorization"))
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [{"id": "project-1", "name": "Production"}]}`))
+	}
+
+	client, server := setupHealthTestClient(handler)
+	defer server.Close()
+
+	result, err := client.Ping(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.ProjectID != "project-1" || result.ProjectName != "Production" || result.Latency <= 0 {
+		t.Errorf("Unexpected ping result %+v", result)
+	}
+}
+
+func TestClient_Ping_NoRetries(t *testing.T) {
+	requests := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("bad gateway"))
+	}
+
+	client, server := setupHealthTestClient(handler)
+	defer server.Close()
+
+	_, err := client.Ping(context.Background())
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error pinging langfuse: server error 502: bad gateway"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected a single attempt, got %d requests", requests)
+	}
+}
+
+func TestClient_Ping_InvalidCredentials(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte("invalid credentials"))
+	}
+
+	client, server := setupHealthTestClient(handler)
+	defer server.Close()
+
+	_, err := client.Ping(context.Background())
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error pinging langfuse: client error 401: invalid credentials"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
+
+func TestClient_Ping_Disabled(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request from a disabled client")
+	}
+
+	client, server := setupHealthTestClient(handler)
+	defer server.Close()
+	client.disabled = true
+
+	_, err := client.Ping(context.Background())
+	if !errors.Is(err, ErrClientDisabled) {
+		t.Errorf("Expected ErrClientDisabled, got %v", err)
+	}
+}
@@ -0,0 +1,472 @@
+package langfuse
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"math"
+	"time"
+)
+
+// IngestionService handles sending tracing events to Langfuse
+type IngestionService service
+
+// Ingestion event types
+const (
+	EventTypeTraceCreate      = "trace-create"
+	EventTypeGenerationCreate = "generation-create"
+	EventTypeGenerationUpdate = "generation-update"
+)
+
+// ObservationLevel represents the severity level of an observation
+type ObservationLevel string
+
+// Observation levels
+const (
+	ObservationLevelDebug   ObservationLevel = "DEBUG"
+	ObservationLevelDefault ObservationLevel = "DEFAULT"
+	ObservationLevelWarning ObservationLevel = "WARNING"
+	ObservationLevelError   ObservationLevel = "ERROR"
+)
+
+// IngestionEvent represents a single event in an ingestion batch
+type IngestionEvent struct {
+	ID        string                 `json:"id"`
+	Type      string                 `json:"type"`
+	Timestamp time.Time              `json:"timestamp"`
+	Body      interface{}            `json:"body"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// TraceBody represents the body of a trace-create event
+type TraceBody struct {
+	ID          string                 `json:"id,omitempty"`
+	Timestamp   *time.Time             `json:"timestamp,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	UserID      string                 `json:"userId,omitempty"`
+	SessionID   string                 `json:"sessionId,omitempty"`
+	Input       interface{}            `json:"input,omitempty"`
+	Output      interface{}            `json:"output,omitempty"`
+	Metadata    map[string]interface{} `json:"metadata,omitempty"`
+	Release     string                 `json:"release,omitempty"`
+	Version     string                 `json:"version,omitempty"`
+	Tags        []string               `json:"tags,omitempty"`
+	Environment string                 `json:"environment,omitempty"`
+	Public      *bool                  `json:"public,omitempty"`
+}
+
+// GenerationBody represents the body of a generation-create or generation-update event.
+// CompletionStartTime marks when the first token was received and is used by
+// Langfuse to compute time-to-first-token. PromptName and PromptVersion link the
+// generation to the prompt version that produced it.
+type GenerationBody struct {
+	ID                  string                 `json:"id,omitempty"`
+	TraceID             string                 `json:"traceId,omitempty"`
+	ParentObservationID string                 `json:"parentObservationId,omitempty"`
+	Name                string                 `json:"name,omitempty"`
+	StartTime           *time.Time             `json:"startTime,omitempty"`
+	EndTime             *time.Time             `json:"endTime,omitempty"`
+	CompletionStartTime *time.Time             `json:"completionStartTime,omitempty"`
+	Model               string                 `json:"model,omitempty"`
+	ModelParameters     map[string]interface{} `json:"modelParameters,omitempty"`
+	Input               interface{}            `json:"input,omitempty"`
+	Output              interface{}            `json:"output,omitempty"`
+	Metadata            map[string]interface{} `json:"metadata,omitempty"`
+	UsageDetails        *Usage                 `json:"usageDetails,omitempty"`
+	CostDetails         *Cost                  `json:"costDetails,omitempty"`
+	Level               ObservationLevel       `json:"level,omitempty"`
+	StatusMessage       string                 `json:"statusMessage,omitempty"`
+	Version             string                 `json:"version,omitempty"`
+	Environment         string                 `json:"environment,omitempty"`
+	PromptName          string                 `json:"promptName,omitempty"`
+	PromptVersion       int                    `json:"promptVersion,omitempty"`
+}
+
+// IngestionRequest represents the request body of the ingestion endpoint
+type IngestionRequest struct {
+	Batch    []*IngestionEvent      `json:"batch"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// IngestionResponse represents the per-event outcome of an ingestion batch.
+// MediaErrors lists the media that could not be uploaded while preparing the
+// batch; such media is sent unchanged.
+type IngestionResponse struct {
+	Successes   []IngestionSuccess `json:"successes"`
+	Errors      []IngestionFailure `json:"errors"`
+	MediaErrors []*MediaError      `json:"-"`
+}
+
+// IngestionSuccess represents a successfully ingested event
+type IngestionSuccess struct {
+	ID     string `json:"id"`
+	Status int    `json:"status"`
+}
+
+// IngestionFailure represents an event that was rejected by Langfuse
+type IngestionFailure struct {
+	ID      string      `json:"id"`
+	Status  int         `json:"status"`
+	Message string      `json:"message,omitempty"`
+	Error   interface{} `json:"error,omitempty"`
+}
+
+// ingestionBody is implemented by event bodies that are subject to sampling and masking
+type ingestionBody interface {
+	// traceID returns the ID of the trace the body belongs to, used as the sampling key
+	traceID() string
+	// observationID returns the ID of the observation the body describes, if any
+	observationID() string
+	// masked returns a copy of the body with mask applied to its input, output and metadata
+	masked(mask MaskFunc) interface{}
+	// withMedia returns a copy of the body with extract applied to its input, output and metadata
+	withMedia(extract mediaExtractor) interface{}
+}
+
+// NewTraceCreateEvent wraps the trace in a trace-create event, assigning a
+// trace ID if none is set.
+func NewTraceCreateEvent(trace *TraceBody) *IngestionEvent {
+	if trace.ID == "" {
+		trace.ID = newID()
+	}
+	return newIngestionEvent(EventTypeTraceCreate, trace)
+}
+
+// NewGenerationCreateEvent wraps the generation in a generation-create event,
+// assigning an observation ID if none is set.
+func NewGenerationCreateEvent(generation *GenerationBody) *IngestionEvent {
+	if generation.ID == "" {
+		generation.ID = newID()
+	}
+	return newIngestionEvent(EventTypeGenerationCreate, generation)
+}
+
+// NewGenerationUpdateEvent wraps the generation in a generation-update event.
+// The generation ID must match that of a previously created generation.
+func NewGenerationUpdateEvent(generation *GenerationBody) *IngestionEvent {
+	return newIngestionEvent(EventTypeGenerationUpdate, generation)
+}
+
+// WithPrompt links the generation to the given prompt version, typically one
+// returned by PromptsService.GetPromptByName. A nil prompt leaves the generation unchanged.
+func (g *GenerationBody) WithPrompt(prompt *Prompt) *GenerationBody {
+	if prompt == nil {
+		return g
+	}
+	return g.WithPromptVersion(prompt.Name, prompt.Version)
+}
+
+// WithPromptVersion links the generation to the prompt with the given name and version
+func (g *GenerationBody) WithPromptVersion(name string, version int) *GenerationBody {
+	g.PromptName = name
+	g.PromptVersion = version
+	return g
+}
+
+// Batch sends a batch of tracing events to Langfuse. Langfuse validates each
+// event individually, so a nil error does not imply that every event was
+// accepted; inspect IngestionResponse.Errors for rejected events.
+//
+// Before sending, events belonging to traces outside the configured sample rate
+// are dropped and the configured MaskFunc is applied to the input, output and
+// metadata of the remaining events, whatever their body type, and to the events'
+// own metadata.
+// Traces without a session ID are assigned the session carried by ctx, if any
+// (see ContextWithSessionID).
+// When the client is disabled, Batch is a no-op returning an empty response.
+// https://api.reference.langfuse.com/#tag/ingestion/post/api/public/ingestion
+func (s *IngestionService) Batch(ctx context.Context, events []*IngestionEvent) (*IngestionResponse, error) {
+	if s.client.disabled {
+		return &IngestionResponse{}, nil
+	}
+
+	events, mediaErrs := s.prepareEvents(ctx, events)
+	if len(events) == 0 {
+		return &IngestionResponse{MediaErrors: mediaErrs}, nil
+	}
+
+	u := "/api/public/ingestion"
+
+	request := &IngestionRequest{
+		Batch: events,
+	}
+
+	body, err := s.client.DoWithContext(ctx, "POST", u, request)
+	if err != nil {
+		return nil, fmt.Errorf("error sending ingestion batch: %w", err)
+	}
+
+	var response IngestionResponse
+	err = json.Unmarshal(body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling ingestion response: %w", err)
+	}
+	response.MediaErrors = mediaErrs
+
+	return &response, nil
+}
+
+type unsampledContextKey struct{}
+
+// withoutSampling returns a copy of ctx under which Batch keeps every event
+// regardless of the client's sample rate. It is used for traces the SDK records
+// itself, such as experiment runs, that must not be dropped.
+func withoutSampling(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unsampledContextKey{}, true)
+}
+
+// prepareEvents applies session assignment, sampling, media extraction and
+// masking to events without modifying them. Media is extracted before masking
+// so that mask functions never see, or alter, encoded media. Media that fails to
+// upload is left in place and reported in the returned errors.
+func (s *IngestionService) prepareEvents(
+	ctx context.Context,
+	events []*IngestionEvent,
+) ([]*IngestionEvent, []*MediaError) {
+	sessionID := SessionIDFromContext(ctx)
+	unsampled, _ := ctx.Value(unsampledContextKey{}).(bool)
+
+	var mediaErrs []*MediaError
+	prepared := make([]*IngestionEvent, 0, len(events))
+	for _, event := range events {
+		if s.client.mask != nil && event.Metadata != nil {
+			maskedEvent := *event
+			maskedEvent.Metadata = maskMetadata(s.client.mask, event.Metadata)
+			event = &maskedEvent
+		}
+
+		body, ok := toIngestionBody(event)
+		if !ok {
+			// Bodies that are not JSON objects cannot be sampled; masking
+			// still applies so that no unmasked data is sent.
+			if s.client.mask != nil {
+				maskedEvent := *event
+				maskedEvent.Body = maskValue(s.client.mask, event.Body)
+				event = &maskedEvent
+			}
+			prepared = append(prepared, event)
+			continue
+		}
+		if _, ok := event.Body.(ingestionBody); !ok {
+			bodyEvent := *event
+			bodyEvent.Body = body
+			event = &bodyEvent
+		}
+
+		if trace, ok := body.(*TraceBody); ok && sessionID != "" && trace.SessionID == "" {
+			sessionTrace := *trace
+			sessionTrace.SessionID = sessionID
+			sessionEvent := *event
+			sessionEvent.Body = &sessionTrace
+			event = &sessionEvent
+			body = &sessionTrace
+		}
+
+		if !unsampled && !isSampled(body.traceID(), s.client.sampleRate) {
+			continue
+		}
+
+		if traceID := body.traceID(); traceID != "" {
+			eventID, observationID := event.ID, body.observationID()
+			extract := func(field string, value interface{}) interface{} {
+				extracted, errs := s.client.Media.extractMedia(ctx, traceID, observationID, field, value)
+				for _, err := range errs {
+					mediaErrs = append(mediaErrs, &MediaError{
+						EventID:       eventID,
+						TraceID:       traceID,
+						ObservationID: observationID,
+						Field:         field,
+						Err:           err,
+					})
+				}
+				return extracted
+			}
+			mediaEvent := *event
+			mediaEvent.Body = body.withMedia(extract)
+			event = &mediaEvent
+			body = mediaEvent.Body.(ingestionBody)
+		}
+
+		if s.client.mask != nil {
+			maskedEvent := *event
+			maskedEvent.Body = body.masked(s.client.mask)
+			event = &maskedEvent
+		}
+
+		prepared = append(prepared, event)
+	}
+
+	return prepared, mediaErrs
+}
+
+// toIngestionBody returns the body of event as an ingestionBody. Value trace
+// and generation bodies are taken by pointer; any other body is converted to its
+// generic JSON representation so that sampling, media extraction and masking
+// apply to it as well. It reports false for bodies that are not JSON objects.
+func toIngestionBody(event *IngestionEvent) (ingestionBody, bool) {
+	switch b := event.Body.(type) {
+	case ingestionBody:
+		return b, true
+	case TraceBody:
+		return &b, true
+	case GenerationBody:
+		return &b, true
+	case nil:
+		return nil, false
+	}
+
+	generic, ok := toGeneric(event.Body)
+	if !ok {
+		return nil, false
+	}
+	fields, ok := generic.(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	return &genericBody{eventType: event.Type, fields: fields}, true
+}
+
+// genericBody is the body of an event of a type without a dedicated body struct,
+// such as span-create, in its generic JSON representation
+type genericBody struct {
+	eventType string
+	fields    map[string]interface{}
+}
+
+func (b *genericBody) MarshalJSON() ([]byte, error) {
+	return json.Marshal(b.fields)
+}
+
+func (b *genericBody) traceID() string {
+	key := "traceId"
+	if b.eventType == EventTypeTraceCreate {
+		key = "id"
+	}
+	id, _ := b.fields[key].(string)
+	return id
+}
+
+func (b *genericBody) observationID() string {
+	if b.eventType == EventTypeTraceCreate || b.eventType == "score-create" {
+		return ""
+	}
+	id, _ := b.fields["id"].(string)
+	return id
+}
+
+func (b *genericBody) masked(mask MaskFunc) interface{} {
+	return b.with(func(field string, value interface{}) interface{} {
+		return maskValue(mask, value)
+	})
+}
+
+func (b *genericBody) withMedia(extract mediaExtractor) interface{} {
+	return b.with(extract)
+}
+
+// with returns a copy of the body with apply applied to its input, output and
+// metadata. Metadata that is no longer an object is dropped.
+func (b *genericBody) with(apply func(field string, value interface{}) interface{}) interface{} {
+	fields := maps.Clone(b.fields)
+	for _, field := range []string{MediaFieldInput, MediaFieldOutput, MediaFieldMetadata} {
+		value, ok := fields[field]
+		if !ok || value == nil {
+			continue
+		}
+		fields[field] = apply(field, value)
+	}
+	if metadata, ok := fields[MediaFieldMetadata]; ok && metadata != nil {
+		if _, ok := metadata.(map[string]interface{}); !ok {
+			delete(fields, MediaFieldMetadata)
+		}
+	}
+	return &genericBody{eventType: b.eventType, fields: fields}
+}
+
+func (t *TraceBody) traceID() string {
+	return t.ID
+}
+
+func (t *TraceBody) masked(mask MaskFunc) interface{} {
+	masked := *t
+	masked.Input = maskValue(mask, t.Input)
+	masked.Output = maskValue(mask, t.Output)
+	masked.Metadata = maskMetadata(mask, t.Metadata)
+	return &masked
+}
+
+func (t *TraceBody) observationID() string {
+	return ""
+}
+
+func (t *TraceBody) withMedia(extract mediaExtractor) interface{} {
+	extracted := *t
+	extracted.Input = extract(MediaFieldInput, t.Input)
+	extracted.Output = extract(MediaFieldOutput, t.Output)
+	extracted.Metadata = extractMetadata(extract, t.Metadata)
+	return &extracted
+}
+
+func (g *GenerationBody) traceID() string {
+	return g.TraceID
+}
+
+func (g *GenerationBody) observationID() string {
+	return g.ID
+}
+
+func (g *GenerationBody) withMedia(extract mediaExtractor) interface{} {
+	extracted := *g
+	extracted.Input = extract(MediaFieldInput, g.Input)
+	extracted.Output = extract(MediaFieldOutput, g.Output)
+	extracted.Metadata = extractMetadata(extract, g.Metadata)
+	return &extracted
+}
+
+func (g *GenerationBody) masked(mask MaskFunc) interface{} {
+	masked := *g
+	masked.Input = maskValue(mask, g.Input)
+	masked.Output = maskValue(mask, g.Output)
+	masked.Metadata = maskMetadata(mask, g.Metadata)
+	return &masked
+}
+
+// isSampled deterministically decides whether the trace with the given ID is
+// kept, so that all events of a trace share the same decision. Events without a
+// trace ID are always kept; otherwise a nil rate keeps every trace and a rate of
+// 0 drops every trace.
+func isSampled(traceID string, rate *float64) bool {
+	if traceID == "" || rate == nil || *rate >= 1 {
+		return true
+	}
+	if *rate <= 0 {
+		return false
+	}
+
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(traceID))
+
+	return float64(h.Sum64())/float64(math.MaxUint64) < *rate
+}
+
+func newIngestionEvent(eventType string, body interface{}) *IngestionEvent {
+	return &IngestionEvent{
+		ID:        newID(),
+		Type:      eventType,
+		Timestamp: time.Now().UTC(),
+		Body:      body,
+	}
+}
+
+// newID returns a random RFC 4122 version 4 UUID
+func newID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
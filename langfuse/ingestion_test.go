@@ -0,0 +1,684 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupIngestionTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.Ingestion = (*IngestionService)(&service{client: client})
+	client.Media = (*MediaService)(&service{client: client})
+
+	return client, server
+}
+
+// decodeIngestionBatch decodes the batch of an ingestion request into generic maps
+func decodeIngestionBatch(t *testing.T, r *http.Request) []map[string]interface{} {
+	t.Helper()
+
+	var request struct {
+		Batch []map[string]interface{} `json:"batch"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		t.Fatalf("Failed to decode ingestion request: %v", err)
+	}
+
+	return request.Batch
+}
+
+func TestIngestionService_Batch_Success(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	firstToken := start.Add(300 * time.Millisecond)
+	end := start.Add(2 * time.Second)
+
+	trace := NewTraceCreateEvent(&TraceBody{Name: "chat", UserID: "user-1"})
+	generation := NewGenerationCreateEvent(&GenerationBody{
+		TraceID:             trace.Body.(*TraceBody).ID,
+		Name:                "completion",
+		Model:               "gpt-4o",
+		ModelParameters:     map[string]interface{}{"temperature": 0.2},
+		StartTime:           &start,
+		CompletionStartTime: &firstToken,
+		EndTime:             &end,
+		UsageDetails:        &Usage{Input: 120, Output: 30, Cached: 100},
+		CostDetails:         &Cost{Input: 0.0012, Output: 0.0009},
+	})
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/api/public/ingestion" {
+			t.Errorf("Expected path /api/public/ingestion, got %s", r.URL.Path)
+		}
+
+		batch := decodeIngestionBatch(t, r)
+		if len(batch) != 2 {
+			t.Fatalf("Expected 2 events, got %d", len(batch))
+		}
+
+		if batch[0]["type"] != "trace-create" {
+			t.Errorf("Expected trace-create, got %v", batch[0]["type"])
+		}
+
+		if batch[1]["type"] != "generation-create" {
+			t.Errorf("Expected generation-create, got %v", batch[1]["type"])
+		}
+
+		body := batch[1]["body"].(map[string]interface{})
+		if body["model"] != "gpt-4o" {
+			t.Errorf("Expected model gpt-4o, got %v", body["model"])
+		}
+
+		if body["completionStartTime"] != "2024-01-01T12:00:00.3Z" {
+			t.Errorf("Unexpected completionStartTime %v", body["completionStartTime"])
+		}
+
+		usage := body["usageDetails"].(map[string]interface{})
+		if usage["input"] != float64(120) || usage["output"] != float64(30) {
+			t.Errorf("Unexpected usageDetails %v", usage)
+		}
+		if usage["input_cached_tokens"] != float64(100) {
+			t.Errorf("Expected input_cached_tokens 100, got %v", usage["input_cached_tokens"])
+		}
+
+		cost := body["costDetails"].(map[string]interface{})
+		if cost["input"] != 0.0012 || cost["output"] != 0.0009 {
+			t.Errorf("Unexpected costDetails %v", cost)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusMultiStatus)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"successes": []interface{}{
+				map[string]interface{}{"id": batch[0]["id"], "status": 201},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{"id": batch[1]["id"], "status": 400, "message": "invalid"},
+			},
+		})
+	}
+
+	client, server := setupIngestionTestClient(handler)
+	defer server.Close()
+
+	response, err := client.Ingestion.Batch(context.Background(), []*IngestionEvent{trace, generation})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(response.Successes) != 1 || response.Successes[0].ID != trace.ID {
+		t.Errorf("Unexpected successes %+v", response.Successes)
+	}
+
+	if len(response.Errors) != 1 || response.Errors[0].Message != "invalid" {
+		t.Errorf("Unexpected errors %+v", response.Errors)
+	}
+}
+
+func TestIngestionService_Batch_Empty(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request for empty batch")
+	}
+
+	client, server := setupIngestionTestClient(handler)
+	defer server.Close()
+
+	response, err := client.Ingestion.Batch(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(response.Successes) != 0 || len(response.Errors) != 0 {
+		t.Errorf("Expected empty response, got %+v", response)
+	}
+}
+
+func TestIngestionService_Batch_Error(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte("unauthorized"))
+	}
+
+	client, server := setupIngestionTestClient(handler)
+	defer server.Close()
+
+	events := []*IngestionEvent{NewTraceCreateEvent(&TraceBody{Name: "trace"})}
+	_, err := client.Ingestion.Batch(context.Background(), events)
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error sending ingestion batch: client error 401: unauthorized"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
+
+func TestNewEvents_AssignIDs(t *testing.T) {
+	uuidPattern := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+	trace := &TraceBody{}
+	event := NewTraceCreateEvent(trace)
+	if !uuidPattern.MatchString(trace.ID) {
+		t.Errorf("Expected generated trace ID to be a UUID, got %s", trace.ID)
+	}
+	if !uuidPattern.MatchString(event.ID) {
+		t.Errorf("Expected generated event ID to be a UUID, got %s", event.ID)
+	}
+	if event.ID == trace.ID {
+		t.Error("Expected event ID to differ from trace ID")
+	}
+
+	generation := &GenerationBody{ID: "gen-1"}
+	NewGenerationCreateEvent(generation)
+	if generation.ID != "gen-1" {
+		t.Errorf("Expected existing generation ID to be preserved, got %s", generation.ID)
+	}
+
+	update := NewGenerationUpdateEvent(&GenerationBody{ID: "gen-1"})
+	if update.Type != EventTypeGenerationUpdate {
+		t.Errorf("Expected %s, got %s", EventTypeGenerationUpdate, update.Type)
+	}
+}
+
+func TestGenerationBody_WithPrompt(t *testing.T) {
+	prompt := &Prompt{Name: "chat-assistant", Version: 3, Type: "chat"}
+
+	event := NewGenerationCreateEvent((&GenerationBody{Name: "completion"}).WithPrompt(prompt))
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		t.Fatalf("Failed to marshal event: %v", err)
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal event: %v", err)
+	}
+
+	body := decoded["body"].(map[string]interface{})
+	if body["promptName"] != "chat-assistant" {
+		t.Errorf("Expected promptName 'chat-assistant', got %v", body["promptName"])
+	}
+	if body["promptVersion"] != float64(3) {
+		t.Errorf("Expected promptVersion 3, got %v", body["promptVersion"])
+	}
+}
+
+func TestGenerationBody_WithPromptVersion(t *testing.T) {
+	generation := (&GenerationBody{}).WithPromptVersion("summarizer", 7)
+
+	if generation.PromptName != "summarizer" || generation.PromptVersion != 7 {
+		t.Errorf("Expected summarizer v7, got %s v%d", generation.PromptName, generation.PromptVersion)
+	}
+}
+
+func TestGenerationBody_WithPrompt_Nil(t *testing.T) {
+	generation := (&GenerationBody{PromptName: "existing", PromptVersion: 1}).WithPrompt(nil)
+
+	if generation.PromptName != "existing" || generation.PromptVersion != 1 {
+		t.Errorf("Expected prompt link to be unchanged, got %s v%d", generation.PromptName, generation.PromptVersion)
+	}
+}
+
+func TestIngestionService_Batch_Sampling(t *testing.T) {
+	var received []map[string]interface{}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, decodeIngestionBatch(t, r)...)
+		w.WriteHeader(http.StatusMultiStatus)
+		w.Write([]byte(`{"successes":[],"errors":[]}`))
+	}
+
+	client, server := setupIngestionTestClient(handler)
+	defer server.Close()
+	rate := 0.5
+	client.sampleRate = &rate
+
+	var events []*IngestionEvent
+	for i := 0; i < 200; i++ {
+		traceID := fmt.Sprintf("trace-%d", i)
+		events = append(events,
+			NewTraceCreateEvent(&TraceBody{ID: traceID}),
+			NewGenerationCreateEvent(&GenerationBody{TraceID: traceID}),
+		)
+	}
+
+	if _, err := client.Ingestion.Batch(context.Background(), events); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(received) == 0 || len(received) == len(events) {
+		t.Fatalf("Expected a subset of events to be sampled, got %d of %d", len(received), len(events))
+	}
+
+	// Trace and generation events of the same trace share a sampling decision
+	traces := map[string]bool{}
+	for _, event := range received {
+		body := event["body"].(map[string]interface{})
+		if event["type"] == "trace-create" {
+			traces[body["id"].(string)] = true
+		}
+	}
+	for _, event := range received {
+		body := event["body"].(map[string]interface{})
+		if event["type"] == "generation-create" && !traces[body["traceId"].(string)] {
+			t.Errorf("Generation of trace %v sent without its trace", body["traceId"])
+		}
+	}
+}
+
+func TestIsSampled(t *testing.T) {
+	zero, partial, one := 0.0, 0.3, 1.0
+
+	if !isSampled("", &zero) {
+		t.Error("Expected events without trace ID to be kept")
+	}
+	if !isSampled("trace-1", nil) || !isSampled("trace-1", &one) {
+		t.Error("Expected an unset rate and a rate of 1 to keep all traces")
+	}
+	if isSampled("trace-1", &zero) {
+		t.Error("Expected a rate of 0 to drop all traces")
+	}
+	if isSampled("trace-1", &partial) != isSampled("trace-1", &partial) {
+		t.Error("Expected sampling to be deterministic")
+	}
+}
+
+func TestIngestionService_Batch_Masking(t *testing.T) {
+	input := map[string]interface{}{"message": "contact me at jane@example.com"}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		batch := decodeIngestionBatch(t, r)
+
+		trace := batch[0]["body"].(map[string]interface{})
+		if trace["input"].(map[string]interface{})["message"] != "contact me at [REDACTED_EMAIL]" {
+			t.Errorf("Expected masked trace input, got %v", trace["input"])
+		}
+
+		generation := batch[1]["body"].(map[string]interface{})
+		if generation["output"] != "card [REDACTED_CREDIT_CARD]" {
+			t.Errorf("Expected masked generation output, got %v", generation["output"])
+		}
+		if generation["metadata"].(map[string]interface{})["key"] != "[REDACTED_API_KEY]" {
+			t.Errorf("Expected masked generation metadata, got %v", generation["metadata"])
+		}
+
+		w.WriteHeader(http.StatusMultiStatus)
+		w.Write([]byte(`{"successes":[],"errors":[]}`))
+	}
+
+	client, server := setupIngestionTestClient(handler)
+	defer server.Close()
+	client.mask = ChainMaskFuncs(MaskEmails, MaskCreditCards, MaskAPIKeys)
+
+	generation := &GenerationBody{
+		Output:   "card 4111 1111 1111 1111",
+		Metadata: map[string]interface{}{"key": "sk-proj-abcdefghijklmnopqrstuvwxyz"},
+	}
+	events := []*IngestionEvent{
+		NewTraceCreateEvent(&TraceBody{Input: input}),
+		NewGenerationCreateEvent(generation),
+	}
+
+	if _, err := client.Ingestion.Batch(context.Background(), events); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	// The caller's data must not be modified
+	if input["message"] != "contact me at jane@example.com" {
+		t.Errorf("Expected original input to be unchanged, got %v", input["message"])
+	}
+	if generation.Output != "card 4111 1111 1111 1111" {
+		t.Errorf("Expected original output to be unchanged, got %v", generation.Output)
+	}
+}
+
+func TestIngestionService_Batch_MaskingCustomBodies(t *testing.T) {
+	type spanBody struct {
+		ID      string `json:"id"`
+		TraceID string `json:"traceId"`
+		Input   string `json:"input"`
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		batch := decodeIngestionBatch(t, r)
+		if len(batch) != 3 {
+			t.Fatalf("Expected 3 events, got %d", len(batch))
+		}
+
+		span := batch[0]["body"].(map[string]interface{})
+		if span["input"] != RedactedEmail || span["id"] != "span-1" || span["traceId"] != "trace-1" {
+			t.Errorf("Expected masked span input, got %v", span)
+		}
+
+		trace := batch[1]["body"].(map[string]interface{})
+		if trace["output"] != "reply to "+RedactedEmail {
+			t.Errorf("Expected masked value trace output, got %v", trace["output"])
+		}
+
+		event := batch[2]["body"].(map[string]interface{})
+		if event["input"].(map[string]interface{})["to"] != RedactedEmail {
+			t.Errorf("Expected masked map body input, got %v", event["input"])
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"successes":[],"errors":[]}`))
+	}
+
+	client, server := setupIngestionTestClient(handler)
+	defer server.Close()
+	client.mask = MaskEmails
+
+	events := []*IngestionEvent{
+		{ID: "e1", Type: "span-create", Body: spanBody{ID: "span-1", TraceID: "trace-1", Input: "a@b.com"}},
+		{ID: "e2", Type: EventTypeTraceCreate, Body: TraceBody{ID: "trace-1", Output: "reply to c@d.com"}},
+		{ID: "e3", Type: "event-create", Body: map[string]interface{}{
+			"id": "event-1", "traceId": "trace-1", "input": map[string]interface{}{"to": "e@f.com"},
+		}},
+	}
+
+	if _, err := client.Ingestion.Batch(context.Background(), events); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestIngestionService_Batch_MaskingEventMetadata(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		batch := decodeIngestionBatch(t, r)
+
+		metadata := batch[0]["metadata"].(map[string]interface{})
+		if metadata["who"] != RedactedEmail {
+			t.Errorf("Expected masked event metadata, got %v", metadata)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"successes":[],"errors":[]}`))
+	}
+
+	client, server := setupIngestionTestClient(handler)
+	defer server.Close()
+	client.mask = MaskEmails
+
+	event := NewTraceCreateEvent(&TraceBody{ID: "trace-1"})
+	event.Metadata = map[string]interface{}{"who": "c@d.com"}
+
+	if _, err := client.Ingestion.Batch(context.Background(), []*IngestionEvent{event}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if event.Metadata["who"] != "c@d.com" {
+		t.Errorf("Expected original event metadata to be unchanged, got %v", event.Metadata)
+	}
+}
+
+func TestIngestionService_Batch_SamplingCustomBodies(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request when every trace is dropped")
+	}
+
+	client, server := setupIngestionTestClient(handler)
+	defer server.Close()
+	rate := 0.0
+	client.sampleRate = &rate
+
+	events := []*IngestionEvent{
+		{ID: "e1", Type: "span-create", Body: map[string]interface{}{"id": "span-1", "traceId": "trace-1"}},
+		{ID: "e2", Type: EventTypeTraceCreate, Body: TraceBody{ID: "trace-1"}},
+	}
+
+	if _, err := client.Ingestion.Batch(context.Background(), events); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestIngestionService_Batch_SessionFromContext(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		batch := decodeIngestionBatch(t, r)
+
+		first := batch[0]["body"].(map[string]interface{})
+		if first["sessionId"] != "session-1" {
+			t.Errorf("Expected trace to be assigned session-1, got %v", first["sessionId"])
+		}
+
+		second := batch[1]["body"].(map[string]interface{})
+		if second["sessionId"] != "explicit" {
+			t.Errorf("Expected explicit session ID to be kept, got %v", second["sessionId"])
+		}
+
+		w.WriteHeader(http.StatusMultiStatus)
+		w.Write([]byte(`{"successes":[],"errors":[]}`))
+	}
+
+	client, server := setupIngestionTestClient(handler)
+	defer server.Close()
+
+	trace := &TraceBody{Name: "turn"}
+	events := []*IngestionEvent{
+		NewTraceCreateEvent(trace),
+		NewTraceCreateEvent(&TraceBody{SessionID: "explicit"}),
+	}
+
+	ctx := ContextWithSessionID(context.Background(), "session-1")
+	if _, err := client.Ingestion.Batch(ctx, events); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if trace.SessionID != "" {
+		t.Errorf("Expected caller's trace to be unchanged, got session %s", trace.SessionID)
+	}
+}
+
+func TestIngestionService_Batch_ExtractsMedia(t *testing.T) {
+	png := []byte("\x89PNG\r\n\x1a\n fake image")
+	dataURI := "data:image/jpeg;base64," + base64.StdEncoding.EncodeToString([]byte("jpeg bytes"))
+
+	var mediaRequests []map[string]interface{}
+	var batch []map[string]interface{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/public/media":
+			var request map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				t.Fatalf("Failed to decode media request: %v", err)
+			}
+			mediaRequests = append(mediaRequests, request)
+
+			if request["contentType"] == "application/pdf" {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte("unsupported content type"))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, `{"mediaId": "media-%d"}`, len(mediaRequests))
+		case "/api/public/ingestion":
+			batch = decodeIngestionBatch(t, r)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"successes": [], "errors": []}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+
+	client, server := setupIngestionTestClient(handler)
+	defer server.Close()
+
+	pdf := "data:application/pdf;base64," + base64.StdEncoding.EncodeToString([]byte("%PDF"))
+	input := map[string]interface{}{"question": "What is this?", "image": dataURI, "attachments": []interface{}{pdf}}
+	trace := &TraceBody{ID: "trace-1", Input: input}
+	generation := &GenerationBody{
+		ID:       "gen-1",
+		TraceID:  "trace-1",
+		Output:   "A cat",
+		Metadata: map[string]interface{}{"thumbnail": png, "checksum": []byte("not media")},
+	}
+
+	response, err := client.Ingestion.Batch(context.Background(), []*IngestionEvent{
+		NewTraceCreateEvent(trace),
+		NewGenerationCreateEvent(generation),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(mediaRequests) != 3 {
+		t.Fatalf("Expected 3 media uploads, got %d", len(mediaRequests))
+	}
+
+	traceInput := batch[0]["body"].(map[string]interface{})["input"].(map[string]interface{})
+	if traceInput["image"] != "@@@langfuseMedia:type=image/jpeg|id=media-1|source=base64_data_uri@@@" &&
+		traceInput["image"] != "@@@langfuseMedia:type=image/jpeg|id=media-2|source=base64_data_uri@@@" {
+		t.Errorf("Expected data URI to be replaced by a media reference, got %v", traceInput["image"])
+	}
+	if traceInput["question"] != "What is this?" {
+		t.Errorf("Expected other input to be unchanged, got %v", traceInput["question"])
+	}
+	if attachments := traceInput["attachments"].([]interface{}); attachments[0] != pdf {
+		t.Errorf("Expected media that failed to upload to be kept, got %v", attachments[0])
+	}
+
+	metadata := batch[1]["body"].(map[string]interface{})["metadata"].(map[string]interface{})
+	if metadata["thumbnail"] != "@@@langfuseMedia:type=image/png|id=media-3|source=bytes@@@" {
+		t.Errorf("Expected bytes to be replaced by a media reference, got %v", metadata["thumbnail"])
+	}
+	if mediaRequests[2]["observationId"] != "gen-1" || mediaRequests[2]["field"] != "metadata" {
+		t.Errorf("Unexpected generation media request %v", mediaRequests[2])
+	}
+
+	if metadata["checksum"] != base64.StdEncoding.EncodeToString([]byte("not media")) {
+		t.Errorf("Expected bytes that are not media to be sent unchanged, got %v", metadata["checksum"])
+	}
+
+	if input["image"] != dataURI {
+		t.Error("Expected caller's input not to be modified")
+	}
+
+	if len(response.MediaErrors) != 1 {
+		t.Fatalf("Expected 1 media error, got %v", response.MediaErrors)
+	}
+	mediaErr := response.MediaErrors[0]
+	expectedError := "media in input of event " + batch[0]["id"].(string) +
+		": error requesting media upload URL: client error 400: unsupported content type"
+	if mediaErr.TraceID != "trace-1" || mediaErr.Field != MediaFieldInput || mediaErr.Error() != expectedError {
+		t.Errorf("Unexpected media error %+v: %v", mediaErr, mediaErr)
+	}
+}
+
+func TestIngestionService_Batch_ExtractsMediaFromStructs(t *testing.T) {
+	type chatRequest struct {
+		Question string `json:"question"`
+		Image    string `json:"image"`
+		Audio    []byte `json:"audio"`
+		Checksum []byte `json:"checksum"`
+	}
+
+	png := []byte("\x89PNG\r\n\x1a\n fake image")
+	wav := []byte("RIFF\x24\x00\x00\x00WAVEfmt fake audio")
+	dataURI := "data:image/png;base64," + base64.StdEncoding.EncodeToString(png)
+
+	var mediaRequests []map[string]interface{}
+	var batch []map[string]interface{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/public/media":
+			var request map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				t.Fatalf("Failed to decode media request: %v", err)
+			}
+			mediaRequests = append(mediaRequests, request)
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprintf(w, `{"mediaId": "media-%s"}`, request["field"])
+		case "/api/public/ingestion":
+			batch = decodeIngestionBatch(t, r)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"successes": [], "errors": []}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+
+	client, server := setupIngestionTestClient(handler)
+	defer server.Close()
+
+	input := &chatRequest{Question: "What is this?", Image: dataURI, Audio: wav, Checksum: []byte("not media")}
+	trace := &TraceBody{ID: "trace-1", Input: input, Output: struct{ Answer string }{"A cat"}}
+
+	_, err := client.Ingestion.Batch(context.Background(), []*IngestionEvent{NewTraceCreateEvent(trace)})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(mediaRequests) != 2 {
+		t.Fatalf("Expected 2 media uploads, got %d", len(mediaRequests))
+	}
+
+	body := batch[0]["body"].(map[string]interface{})
+	traceInput := body["input"].(map[string]interface{})
+	if traceInput["image"] != "@@@langfuseMedia:type=image/png|id=media-input|source=base64_data_uri@@@" {
+		t.Errorf("Expected data URI in struct to be replaced by a media reference, got %v", traceInput["image"])
+	}
+	if traceInput["audio"] != "@@@langfuseMedia:type=audio/wave|id=media-input|source=bytes@@@" {
+		t.Errorf("Expected bytes in struct to be replaced by a media reference, got %v", traceInput["audio"])
+	}
+	if traceInput["question"] != "What is this?" ||
+		traceInput["checksum"] != base64.StdEncoding.EncodeToString([]byte("not media")) {
+		t.Errorf("Expected other struct fields to be unchanged, got %v", traceInput)
+	}
+	if output := body["output"].(map[string]interface{}); output["Answer"] != "A cat" {
+		t.Errorf("Expected struct without media to be sent unchanged, got %v", output)
+	}
+
+	if input.Image != dataURI {
+		t.Error("Expected caller's struct not to be modified")
+	}
+}
+
+func TestParseDataURI(t *testing.T) {
+	tests := []struct {
+		uri         string
+		contentType string
+		ok          bool
+	}{
+		{"data:image/png;base64,aGVsbG8=", "image/png", true},
+		{"data:audio/wav;charset=utf-8;base64,aGVsbG8=", "audio/wav", true},
+		{"data:text/plain,hello", "", false},
+		{"data:;base64,aGVsbG8=", "", false},
+		{"data:image/png;base64,not base64!", "", false},
+		{"https://example.com/image.png", "", false},
+	}
+
+	for _, tt := range tests {
+		contentType, data, ok := parseDataURI(tt.uri)
+		if ok != tt.ok || contentType != tt.contentType {
+			t.Errorf("parseDataURI(%q) = %q, %v; want %q, %v", tt.uri, contentType, ok, tt.contentType, tt.ok)
+		}
+		if ok && string(data) != "hello" {
+			t.Errorf("parseDataURI(%q) data = %q, want hello", tt.uri, data)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"time"
+)
+
+// LLMConnectionsService handles the LLM provider connections used by the playground and evaluators
+type LLMConnectionsService service
+
+// LLMAdapter represents the API schema used to talk to an LLM provider
+type LLMAdapter string
+
+// LLM adapters
+const (
+	LLMAdapterAnthropic      LLMAdapter = "anthropic"
+	LLMAdapterOpenAI         LLMAdapter = "openai"
+	LLMAdapterAzure          LLMAdapter = "azure"
+	LLMAdapterBedrock        LLMAdapter = "bedrock"
+	LLMAdapterGoogleVertexAI LLMAdapter = "google-vertex-ai"
+	LLMAdapterGoogleAIStudio LLMAdapter = "google-ai-studio"
+)
+
+// LLMConnection represents an LLM provider connection of a project. The secret
+// key and extra header values are never returned; DisplaySecretKey is a masked
+// form of the key and ExtraHeaderKeys lists the configured header names.
+type LLMConnection struct {
+	ID                string     `json:"id"`
+	Provider          string     `json:"provider"`
+	Adapter           LLMAdapter `json:"adapter"`
+	DisplaySecretKey  string     `json:"displaySecretKey"`
+	BaseURL           string     `json:"baseURL,omitempty"`
+	CustomModels      []string   `json:"customModels"`
+	WithDefaultModels bool       `json:"withDefaultModels"`
+	ExtraHeaderKeys   []string   `json:"extraHeaderKeys"`
+	CreatedAt         *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt         *time.Time `json:"updatedAt,omitempty"`
+}
+
+// LLMConnectionList represents a page of LLM connections
+type LLMConnectionList struct {
+	Data []LLMConnection `json:"data"`
+	Meta MetaResponse    `json:"meta"`
+}
+
+// UpsertLLMConnectionRequest represents the request body for creating or updating
+// an LLM connection. Provider is the unique name of the connection within the
+// project. WithDefaultModels defaults to true on the server when nil.
+type UpsertLLMConnectionRequest struct {
+	Provider          string            `json:"provider"`
+	Adapter           LLMAdapter        `json:"adapter"`
+	SecretKey         string            `json:"secretKey"`
+	BaseURL           string            `json:"baseURL,omitempty"`
+	CustomModels      []string          `json:"customModels,omitempty"`
+	WithDefaultModels *bool             `json:"withDefaultModels,omitempty"`
+	ExtraHeaders      map[string]string `json:"extraHeaders,omitempty"`
+}
+
+// List retrieves a page of the project's LLM connections. Zero page and limit use the server defaults.
+// https://api.reference.langfuse.com/#tag/llmconnections/get/api/public/llm-connections
+func (s *LLMConnectionsService) List(ctx context.Context, page, limit int) (*LLMConnectionList, error) {
+	params := url.Values{}
+	setInt(params, "page", page)
+	setInt(params, "limit", limit)
+	u := withQuery("/api/public/llm-connections", params)
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing LLM connections: %w", err)
+	}
+
+	var connections LLMConnectionList
+	err = json.Unmarshal(body, &connections)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling LLM connections data: %w", err)
+	}
+
+	return &connections, nil
+}
+
+// All iterates over all LLM connections of the project, fetching pages as needed
+func (s *LLMConnectionsService) All(ctx context.Context) iter.Seq2[LLMConnection, error] {
+	return paginate(ctx, 1, func(ctx context.Context, page int) ([]LLMConnection, *MetaResponse, error) {
+		connections, err := s.List(ctx, page, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		return connections.Data, &connections.Meta, nil
+	})
+}
+
+// Upsert creates an LLM connection, or replaces the connection with the same provider name
+// https://api.reference.langfuse.com/#tag/llmconnections/put/api/public/llm-connections
+func (s *LLMConnectionsService) Upsert(
+	ctx context.Context,
+	connection UpsertLLMConnectionRequest,
+) (*LLMConnection, error) {
+	if connection.Provider == "" || connection.Adapter == "" || connection.SecretKey == "" {
+		return nil, errors.New("error upserting LLM connection: provider, adapter and secret key are required")
+	}
+
+	body, err := s.client.DoWithContext(ctx, "PUT", "/api/public/llm-connections", &connection)
+	if err != nil {
+		return nil, fmt.Errorf("error upserting LLM connection: %w", err)
+	}
+
+	var upserted LLMConnection
+	err = json.Unmarshal(body, &upserted)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling LLM connection data: %w", err)
+	}
+
+	return &upserted, nil
+}
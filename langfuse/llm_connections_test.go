@@ -0,0 +1,165 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupLLMConnectionsTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.LLMConnections = (*LLMConnectionsService)(&service{client: client})
+
+	return client, server
+}
+
+func TestLLMConnectionsService_List_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/llm-connections" {
+			t.Errorf("Expected path /api/public/llm-connections, got %s", r.URL.Path)
+		}
+
+		if r.URL.Query().Get("page") != "2" || r.URL.Query().Get("limit") != "10" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [{"id": "conn-1", "provider": "openai", "adapter": "openai",
+			"displaySecretKey": "...abcd", "customModels": [], "withDefaultModels": true,
+			"extraHeaderKeys": ["OpenAI-Organization"]}],
+			"meta": {"page": 2, "limit": 10, "totalItems": 11, "totalPages": 2}}`))
+	}
+
+	client, server := setupLLMConnectionsTestClient(handler)
+	defer server.Close()
+
+	connections, err := client.LLMConnections.List(context.Background(), 2, 10)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(connections.Data) != 1 || connections.Meta.TotalItems != 11 {
+		t.Fatalf("Unexpected connections %+v", connections)
+	}
+
+	connection := connections.Data[0]
+	if connection.Adapter != LLMAdapterOpenAI || !connection.WithDefaultModels ||
+		connection.ExtraHeaderKeys[0] != "OpenAI-Organization" {
+		t.Errorf("Unexpected connection %+v", connection)
+	}
+}
+
+func TestLLMConnectionsService_All(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		if r.URL.Query().Get("page") == "1" {
+			w.Write([]byte(`{"data": [{"id": "conn-1"}], "meta": {"page": 1, "totalPages": 2}}`))
+		} else {
+			w.Write([]byte(`{"data": [{"id": "conn-2"}], "meta": {"page": 2, "totalPages": 2}}`))
+		}
+	}
+
+	client, server := setupLLMConnectionsTestClient(handler)
+	defer server.Close()
+
+	var ids []string
+	for connection, err := range client.LLMConnections.All(context.Background()) {
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		ids = append(ids, connection.ID)
+	}
+
+	if len(ids) != 2 || ids[0] != "conn-1" || ids[1] != "conn-2" {
+		t.Errorf("Expected [conn-1 conn-2], got %v", ids)
+	}
+}
+
+func TestLLMConnectionsService_Upsert_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" || r.URL.Path != "/api/public/llm-connections" {
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		if payload["provider"] != "azure-prod" || payload["adapter"] != "azure" || payload["secretKey"] != "secret" ||
+			payload["withDefaultModels"] != false || payload["baseURL"] != "https://example.openai.azure.com" {
+			t.Errorf("Unexpected payload %v", payload)
+		}
+
+		headers, _ := payload["extraHeaders"].(map[string]interface{})
+		if headers["api-version"] != "2024-06-01" {
+			t.Errorf("Unexpected extra headers %v", payload["extraHeaders"])
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "conn-1", "provider": "azure-prod", "adapter": "azure", "displaySecretKey": "...cret",
+			"baseURL": "https://example.openai.azure.com", "customModels": ["gpt-4o"], "withDefaultModels": false,
+			"extraHeaderKeys": ["api-version"]}`))
+	}
+
+	client, server := setupLLMConnectionsTestClient(handler)
+	defer server.Close()
+
+	withDefaults := false
+	connection, err := client.LLMConnections.Upsert(context.Background(), UpsertLLMConnectionRequest{
+		Provider:          "azure-prod",
+		Adapter:           LLMAdapterAzure,
+		SecretKey:         "secret",
+		BaseURL:           "https://example.openai.azure.com",
+		CustomModels:      []string{"gpt-4o"},
+		WithDefaultModels: &withDefaults,
+		ExtraHeaders:      map[string]string{"api-version": "2024-06-01"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if connection.ID != "conn-1" || connection.DisplaySecretKey != "...cret" || connection.CustomModels[0] != "gpt-4o" {
+		t.Errorf("Unexpected connection %+v", connection)
+	}
+}
+
+func TestLLMConnectionsService_Upsert_RequiredFields(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request for invalid connection")
+	}
+
+	client, server := setupLLMConnectionsTestClient(handler)
+	defer server.Close()
+
+	_, err := client.LLMConnections.Upsert(context.Background(), UpsertLLMConnectionRequest{
+		Provider: "openai",
+		Adapter:  LLMAdapterOpenAI,
+	})
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error upserting LLM connection: provider, adapter and secret key are required"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
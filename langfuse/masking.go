@@ -0,0 +1,186 @@
+package langfuse
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// MaskFunc transforms data before it leaves the process. When configured via
+// Config.MaskFunc it is applied to the input, output and metadata of every
+// ingestion event.
+type MaskFunc func(data interface{}) interface{}
+
+// Replacement values used by the built-in maskers
+const (
+	RedactedEmail      = "[REDACTED_EMAIL]"
+	RedactedCreditCard = "[REDACTED_CREDIT_CARD]"
+	RedactedAPIKey     = "[REDACTED_API_KEY]"
+)
+
+var (
+	emailPattern      = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	creditCardPattern = regexp.MustCompile(`\b(?:\d[ \-]?){12,18}\d\b`)
+	apiKeyPattern     = regexp.MustCompile(
+		`\b(?:sk|pk)-(?:lf-|proj-|ant-)?[A-Za-z0-9_\-]{16,}` + // OpenAI, Anthropic and Langfuse keys
+			`|\bAKIA[0-9A-Z]{16}\b` + // AWS access key IDs
+			`|\bgh[pousr]_[A-Za-z0-9]{36,}\b` + // GitHub tokens
+			`|\bxox[abprs]-[A-Za-z0-9\-]{10,}` + // Slack tokens
+			`|(?i:\bbearer\s+)[A-Za-z0-9\-._~+/]{16,}=*`, // Bearer tokens
+	)
+)
+
+// MaskEmails replaces email addresses in all strings of data with RedactedEmail
+func MaskEmails(data interface{}) interface{} {
+	return MaskStrings(func(s string) string {
+		return emailPattern.ReplaceAllString(s, RedactedEmail)
+	})(data)
+}
+
+// MaskCreditCards replaces credit card numbers that pass the Luhn check in all
+// strings of data with RedactedCreditCard
+func MaskCreditCards(data interface{}) interface{} {
+	return MaskStrings(func(s string) string {
+		return creditCardPattern.ReplaceAllStringFunc(s, func(match string) string {
+			if !luhnValid(match) {
+				return match
+			}
+			return RedactedCreditCard
+		})
+	})(data)
+}
+
+// MaskAPIKeys replaces common API key and bearer token formats in all strings
+// of data with RedactedAPIKey
+func MaskAPIKeys(data interface{}) interface{} {
+	return MaskStrings(func(s string) string {
+		return apiKeyPattern.ReplaceAllString(s, RedactedAPIKey)
+	})(data)
+}
+
+// ChainMaskFuncs returns a MaskFunc applying each of the given masks in order
+//
+// Example:
+//
+//	config.MaskFunc = langfuse.ChainMaskFuncs(
+//	    langfuse.MaskEmails,
+//	    langfuse.MaskCreditCards,
+//	    langfuse.MaskAPIKeys,
+//	)
+func ChainMaskFuncs(masks ...MaskFunc) MaskFunc {
+	return func(data interface{}) interface{} {
+		for _, mask := range masks {
+			data = mask(data)
+		}
+		return data
+	}
+}
+
+// MaskStrings returns a MaskFunc that applies replace to every string found in
+// data, recursing into maps and slices. Values of other types, such as structs,
+// are converted to their JSON representation first. The data passed in is never
+// modified; masked copies are returned instead.
+func MaskStrings(replace func(string) string) MaskFunc {
+	var walk func(v interface{}) interface{}
+	walk = func(v interface{}) interface{} {
+		switch val := v.(type) {
+		case nil, bool, float64, float32, int, int32, int64, uint, uint32, uint64, json.Number:
+			return val
+		case string:
+			return replace(val)
+		case []string:
+			masked := make([]string, len(val))
+			for i, s := range val {
+				masked[i] = replace(s)
+			}
+			return masked
+		case map[string]string:
+			masked := make(map[string]string, len(val))
+			for k, s := range val {
+				masked[k] = replace(s)
+			}
+			return masked
+		case []interface{}:
+			masked := make([]interface{}, len(val))
+			for i, item := range val {
+				masked[i] = walk(item)
+			}
+			return masked
+		case map[string]interface{}:
+			masked := make(map[string]interface{}, len(val))
+			for k, item := range val {
+				masked[k] = walk(item)
+			}
+			return masked
+		default:
+			generic, ok := toGeneric(val)
+			if !ok {
+				return val
+			}
+			return walk(generic)
+		}
+	}
+	return walk
+}
+
+// toGeneric converts v into its generic JSON representation (maps, slices and primitives)
+func toGeneric(v interface{}) (interface{}, bool) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, false
+	}
+
+	var generic interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil, false
+	}
+
+	return generic, true
+}
+
+// luhnValid reports whether the digits in number pass the Luhn checksum
+func luhnValid(number string) bool {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(number)
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+// maskValue applies mask to a non-nil value
+func maskValue(mask MaskFunc, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	return mask(value)
+}
+
+// maskMetadata applies mask to metadata, discarding the result if the mask does
+// not return a map
+func maskMetadata(mask MaskFunc, metadata map[string]interface{}) map[string]interface{} {
+	if metadata == nil {
+		return nil
+	}
+
+	masked, ok := mask(metadata).(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	return masked
+}
@@ -0,0 +1,457 @@
+package langfuse
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// MediaService handles uploads of media attachments referenced from traces and observations
+type MediaService service
+
+// MediaSource describes where uploaded media originally came from
+type MediaSource string
+
+// Media sources
+const (
+	MediaSourceBase64DataURI MediaSource = "base64_data_uri"
+	MediaSourceBytes         MediaSource = "bytes"
+	MediaSourceFile          MediaSource = "file"
+)
+
+// Media fields of a trace or observation
+const (
+	MediaFieldInput    = "input"
+	MediaFieldOutput   = "output"
+	MediaFieldMetadata = "metadata"
+)
+
+// MediaUpload describes media to attach to a trace, or to an observation when
+// ObservationID is set. Field is one of MediaFieldInput, MediaFieldOutput or
+// MediaFieldMetadata. Source defaults to MediaSourceBytes.
+type MediaUpload struct {
+	TraceID       string
+	ObservationID string
+	Field         string
+	ContentType   string
+	Data          []byte
+	Source        MediaSource
+}
+
+// MediaReference identifies uploaded media. Its String form is the token Langfuse
+// resolves when it appears in trace or observation input, output or metadata.
+type MediaReference struct {
+	MediaID     string
+	ContentType string
+	Source      MediaSource
+}
+
+// Media represents the metadata of uploaded media. URL is a presigned download
+// URL valid until URLExpiry.
+type Media struct {
+	MediaID       string     `json:"mediaId"`
+	ContentType   string     `json:"contentType"`
+	ContentLength int64      `json:"contentLength"`
+	UploadedAt    *time.Time `json:"uploadedAt,omitempty"`
+	URL           string     `json:"url"`
+	URLExpiry     string     `json:"urlExpiry"`
+}
+
+// MediaError reports media found in an ingestion event that could not be
+// uploaded. The media is sent unchanged.
+type MediaError struct {
+	EventID       string
+	TraceID       string
+	ObservationID string
+	Field         string
+	Err           error
+}
+
+func (e *MediaError) Error() string {
+	return fmt.Sprintf("media in %s of event %s: %v", e.Field, e.EventID, e.Err)
+}
+
+func (e *MediaError) Unwrap() error {
+	return e.Err
+}
+
+// mediaExtractor replaces media in the value of a trace or observation field with media references
+type mediaExtractor func(field string, value interface{}) interface{}
+
+// uploadURLRequest represents the request body for requesting a media upload URL
+type uploadURLRequest struct {
+	TraceID       string `json:"traceId"`
+	ObservationID string `json:"observationId,omitempty"`
+	ContentType   string `json:"contentType"`
+	ContentLength int    `json:"contentLength"`
+	SHA256Hash    string `json:"sha256Hash"`
+	Field         string `json:"field"`
+}
+
+// uploadURLResponse represents the upload URL response. UploadURL is empty if
+// media with the same hash was already uploaded.
+type uploadURLResponse struct {
+	UploadURL string `json:"uploadUrl"`
+	MediaID   string `json:"mediaId"`
+}
+
+// uploadStatusRequest represents the request body for reporting an upload result
+type uploadStatusRequest struct {
+	UploadedAt       time.Time `json:"uploadedAt"`
+	UploadHTTPStatus int       `json:"uploadHttpStatus"`
+	UploadHTTPError  string    `json:"uploadHttpError,omitempty"`
+	UploadTimeMs     int64     `json:"uploadTimeMs"`
+}
+
+// String returns the media reference token
+func (r MediaReference) String() string {
+	return fmt.Sprintf("@@@langfuseMedia:type=%s|id=%s|source=%s@@@", r.ContentType, r.MediaID, r.Source)
+}
+
+// Upload uploads media and returns a reference to use in place of the raw data.
+// The bytes are sent directly to the presigned storage URL Langfuse returns, and
+// the outcome is reported back to Langfuse. Media that was uploaded before is
+// not uploaded again.
+// https://api.reference.langfuse.com/#tag/media/post/api/public/media
+func (s *MediaService) Upload(ctx context.Context, upload MediaUpload) (*MediaReference, error) {
+	if upload.TraceID == "" {
+		return nil, errors.New("error uploading media: trace ID is required")
+	}
+	if upload.ContentType == "" {
+		return nil, errors.New("error uploading media: content type is required")
+	}
+	if len(upload.Data) == 0 {
+		return nil, errors.New("error uploading media: data is required")
+	}
+	switch upload.Field {
+	case MediaFieldInput, MediaFieldOutput, MediaFieldMetadata:
+	default:
+		return nil, fmt.Errorf("error uploading media: invalid field %q", upload.Field)
+	}
+
+	source := upload.Source
+	if source == "" {
+		source = MediaSourceBytes
+	}
+
+	sum := sha256.Sum256(upload.Data)
+	hash := base64.StdEncoding.EncodeToString(sum[:])
+
+	body, err := s.client.DoWithContext(ctx, "POST", "/api/public/media", &uploadURLRequest{
+		TraceID:       upload.TraceID,
+		ObservationID: upload.ObservationID,
+		ContentType:   upload.ContentType,
+		ContentLength: len(upload.Data),
+		SHA256Hash:    hash,
+		Field:         upload.Field,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error requesting media upload URL: %w", err)
+	}
+
+	var target uploadURLResponse
+	err = json.Unmarshal(body, &target)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling media upload URL data: %w", err)
+	}
+
+	if target.UploadURL != "" {
+		err = s.uploadToStorage(ctx, target, upload.ContentType, hash, upload.Data)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &MediaReference{MediaID: target.MediaID, ContentType: upload.ContentType, Source: source}, nil
+}
+
+// Get retrieves the metadata and a download URL of uploaded media
+// https://api.reference.langfuse.com/#tag/media/get/api/public/media/{mediaId}
+func (s *MediaService) Get(ctx context.Context, mediaID string) (*Media, error) {
+	u := fmt.Sprintf("/api/public/media/%s", url.PathEscape(mediaID))
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching media: %w", err)
+	}
+
+	var media Media
+	err = json.Unmarshal(body, &media)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling media data: %w", err)
+	}
+
+	return &media, nil
+}
+
+// uploadToStorage PUTs the data to the presigned URL and reports the result to Langfuse
+func (s *MediaService) uploadToStorage(
+	ctx context.Context,
+	target uploadURLResponse,
+	contentType, hash string,
+	data []byte,
+) error {
+	start := time.Now()
+	status, uploadErr := s.put(ctx, target.UploadURL, contentType, hash, data)
+
+	report := &uploadStatusRequest{
+		UploadedAt:       time.Now().UTC(),
+		UploadHTTPStatus: status,
+		UploadTimeMs:     time.Since(start).Milliseconds(),
+	}
+	if uploadErr != nil {
+		report.UploadHTTPError = uploadErr.Error()
+	}
+
+	u := fmt.Sprintf("/api/public/media/%s", url.PathEscape(target.MediaID))
+	_, err := s.client.DoWithContext(ctx, "PATCH", u, report)
+
+	if uploadErr != nil {
+		return fmt.Errorf("error uploading media: %w", uploadErr)
+	}
+	if err != nil {
+		return fmt.Errorf("error updating media upload status: %w", err)
+	}
+
+	return nil
+}
+
+// put sends the data to the storage URL. The URL is presigned, so no
+// Authorization header is sent.
+func (s *MediaService) put(ctx context.Context, uploadURL, contentType, hash string, data []byte) (int, error) {
+	req, err := retryablehttp.NewRequestWithContext(ctx, "PUT", uploadURL, bytes.NewReader(data))
+	if err != nil {
+		return 0, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("x-amz-checksum-sha256", hash)
+
+	resp, err := s.client.retryableClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("error making request: %w", err)
+	}
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			return
+		}
+	}()
+
+	if resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, fmt.Errorf("storage error %d: %s", resp.StatusCode, string(body))
+	}
+
+	return resp.StatusCode, nil
+}
+
+// extractMedia uploads the base64 data URIs and the image, audio and video
+// []byte values found in value, recursing into maps and slices, and returns a
+// copy of value with each replaced by its media reference. Structs and other
+// types are inspected through their generic JSON representation, in which
+// []byte fields are base64 strings; the generic form replaces the original only
+// if it contained media. Values that fail to upload are kept as they are and
+// their errors returned; value itself is returned when it contains no media.
+func (s *MediaService) extractMedia(
+	ctx context.Context,
+	traceID, observationID, field string,
+	value interface{},
+) (interface{}, []error) {
+	var errs []error
+	upload := func(original interface{}, contentType string, data []byte, source MediaSource) (interface{}, bool) {
+		ref, err := s.Upload(ctx, MediaUpload{
+			TraceID:       traceID,
+			ObservationID: observationID,
+			Field:         field,
+			ContentType:   contentType,
+			Data:          data,
+			Source:        source,
+		})
+		if err != nil {
+			errs = append(errs, err)
+			return original, false
+		}
+		return ref.String(), true
+	}
+
+	replaceString := func(v string) (string, bool) {
+		contentType, data, ok := parseDataURI(v)
+		if !ok {
+			return v, false
+		}
+		replaced, ok := upload(v, contentType, data, MediaSourceBase64DataURI)
+		return replaced.(string), ok
+	}
+
+	// replaceEncoded replaces a string of a struct's generic form, where []byte
+	// fields are encoded as plain base64
+	replaceEncoded := func(v string) (string, bool) {
+		if replaced, ok := replaceString(v); ok {
+			return replaced, true
+		}
+		data, err := base64.StdEncoding.DecodeString(v)
+		if err != nil || len(data) == 0 {
+			return v, false
+		}
+		contentType := http.DetectContentType(data)
+		if !isMediaContentType(contentType) {
+			return v, false
+		}
+		replaced, ok := upload(v, contentType, data, MediaSourceBytes)
+		return replaced.(string), ok
+	}
+
+	// walk replaces the media in v; encoded is set within a struct's generic form
+	var walk func(v interface{}, encoded bool) (interface{}, bool)
+	walk = func(v interface{}, encoded bool) (interface{}, bool) {
+		switch val := v.(type) {
+		case nil, bool, float64, float32, int, int32, int64, uint, uint32, uint64, json.Number:
+			return val, false
+		case string:
+			if encoded {
+				return replaceEncoded(val)
+			}
+			return replaceString(val)
+		case []byte:
+			contentType := http.DetectContentType(val)
+			if len(val) == 0 || !isMediaContentType(contentType) {
+				return val, false
+			}
+			return upload(val, contentType, val, MediaSourceBytes)
+		case []string:
+			var out []string
+			for i, item := range val {
+				if replaced, ok := replaceString(item); ok {
+					if out == nil {
+						out = slices.Clone(val)
+					}
+					out[i] = replaced
+				}
+			}
+			if out == nil {
+				return val, false
+			}
+			return out, true
+		case map[string]string:
+			var out map[string]string
+			for k, item := range val {
+				if replaced, ok := replaceString(item); ok {
+					if out == nil {
+						out = maps.Clone(val)
+					}
+					out[k] = replaced
+				}
+			}
+			if out == nil {
+				return val, false
+			}
+			return out, true
+		case []interface{}:
+			var out []interface{}
+			for i, item := range val {
+				if replaced, ok := walk(item, encoded); ok {
+					if out == nil {
+						out = slices.Clone(val)
+					}
+					out[i] = replaced
+				}
+			}
+			if out == nil {
+				return val, false
+			}
+			return out, true
+		case map[string]interface{}:
+			var out map[string]interface{}
+			for k, item := range val {
+				if replaced, ok := walk(item, encoded); ok {
+					if out == nil {
+						out = maps.Clone(val)
+					}
+					out[k] = replaced
+				}
+			}
+			if out == nil {
+				return val, false
+			}
+			return out, true
+		default:
+			generic, ok := toGeneric(val)
+			if !ok {
+				return val, false
+			}
+			replaced, ok := walk(generic, true)
+			if !ok {
+				return val, false
+			}
+			return replaced, true
+		}
+	}
+
+	extracted, _ := walk(value, false)
+	return extracted, errs
+}
+
+// isMediaContentType reports whether a sniffed content type is image, audio or
+// video. Other byte slices are not treated as media.
+func isMediaContentType(contentType string) bool {
+	return strings.HasPrefix(contentType, "image/") ||
+		strings.HasPrefix(contentType, "audio/") ||
+		strings.HasPrefix(contentType, "video/")
+}
+
+// parseDataURI decodes a base64 data URI such as "data:image/png;base64,iVBOR..."
+func parseDataURI(s string) (string, []byte, bool) {
+	rest, ok := strings.CutPrefix(s, "data:")
+	if !ok {
+		return "", nil, false
+	}
+
+	header, encoded, ok := strings.Cut(rest, ",")
+	if !ok {
+		return "", nil, false
+	}
+
+	header, ok = strings.CutSuffix(header, ";base64")
+	if !ok {
+		return "", nil, false
+	}
+
+	contentType, _, _ := strings.Cut(header, ";")
+	if contentType == "" {
+		return "", nil, false
+	}
+
+	data, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(data) == 0 {
+		return "", nil, false
+	}
+
+	return contentType, data, true
+}
+
+// extractMetadata applies extract to metadata, keeping the original if the result is not a map
+func extractMetadata(extract mediaExtractor, metadata map[string]interface{}) map[string]interface{} {
+	if metadata == nil {
+		return nil
+	}
+
+	extracted, ok := extract(MediaFieldMetadata, metadata).(map[string]interface{})
+	if !ok {
+		return metadata
+	}
+
+	return extracted
+}
@@ -0,0 +1,231 @@
+package langfuse
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupMediaTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.Media = (*MediaService)(&service{client: client})
+
+	return client, server
+}
+
+// mediaTestServer stands in for both the Langfuse media endpoints and the presigned storage bucket
+type mediaTestServer struct {
+	t             *testing.T
+	serverURL     string
+	storageStatus int
+	uploadURL     bool
+	stored        []byte
+	report        map[string]interface{}
+}
+
+func (m *mediaTestServer) handle(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == "POST" && r.URL.Path == "/api/public/media":
+		var request map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			m.t.Fatalf("Failed to decode request body: %v", err)
+		}
+		if request["traceId"] != "trace-1" || request["field"] != "input" || request["contentType"] != "image/png" {
+			m.t.Errorf("Unexpected upload URL request %v", request)
+		}
+
+		response := map[string]string{"mediaId": "media-1"}
+		if m.uploadURL {
+			response["uploadUrl"] = m.serverURL + "/storage/media-1?X-Amz-Signature=abc"
+		}
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(response)
+	case r.Method == "PUT" && r.URL.Path == "/storage/media-1":
+		if r.Header.Get("Authorization") != "" {
+			m.t.Error("Expected no Authorization header for presigned upload")
+		}
+		if r.Header.Get("Content-Type") != "image/png" {
+			m.t.Errorf("Expected Content-Type image/png, got %s", r.Header.Get("Content-Type"))
+		}
+
+		data, _ := io.ReadAll(r.Body)
+		sum := sha256.Sum256(data)
+		if r.Header.Get("x-amz-checksum-sha256") != base64.StdEncoding.EncodeToString(sum[:]) {
+			m.t.Errorf("Checksum header does not match body")
+		}
+
+		m.stored = data
+		w.WriteHeader(m.storageStatus)
+	case r.Method == "PATCH" && r.URL.Path == "/api/public/media/media-1":
+		if err := json.NewDecoder(r.Body).Decode(&m.report); err != nil {
+			m.t.Fatalf("Failed to decode request body: %v", err)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		m.t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestMediaService_Upload_Success(t *testing.T) {
+	stub := &mediaTestServer{t: t, storageStatus: http.StatusOK, uploadURL: true}
+	client, server := setupMediaTestClient(stub.handle)
+	defer server.Close()
+	stub.serverURL = server.URL
+
+	data := []byte("\x89PNG fake image")
+	ref, err := client.Media.Upload(context.Background(), MediaUpload{
+		TraceID:     "trace-1",
+		Field:       MediaFieldInput,
+		ContentType: "image/png",
+		Data:        data,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := "@@@langfuseMedia:type=image/png|id=media-1|source=bytes@@@"
+	if ref.String() != expected {
+		t.Errorf("Expected reference %s, got %s", expected, ref.String())
+	}
+
+	if !bytes.Equal(stub.stored, data) {
+		t.Errorf("Expected storage to receive the media bytes")
+	}
+
+	if stub.report["uploadHttpStatus"] != 200.0 || stub.report["uploadedAt"] == nil {
+		t.Errorf("Unexpected upload status report %v", stub.report)
+	}
+}
+
+func TestMediaService_Upload_AlreadyUploaded(t *testing.T) {
+	stub := &mediaTestServer{t: t, uploadURL: false}
+	client, server := setupMediaTestClient(stub.handle)
+	defer server.Close()
+
+	ref, err := client.Media.Upload(context.Background(), MediaUpload{
+		TraceID:     "trace-1",
+		Field:       MediaFieldInput,
+		ContentType: "image/png",
+		Data:        []byte("image"),
+		Source:      MediaSourceFile,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if stub.stored != nil || stub.report != nil {
+		t.Error("Expected no upload for media that already exists")
+	}
+
+	if ref.Source != MediaSourceFile {
+		t.Errorf("Expected source file, got %s", ref.Source)
+	}
+}
+
+func TestMediaService_Upload_StorageError(t *testing.T) {
+	stub := &mediaTestServer{t: t, storageStatus: http.StatusForbidden, uploadURL: true}
+	client, server := setupMediaTestClient(stub.handle)
+	defer server.Close()
+	stub.serverURL = server.URL
+
+	_, err := client.Media.Upload(context.Background(), MediaUpload{
+		TraceID:     "trace-1",
+		Field:       MediaFieldInput,
+		ContentType: "image/png",
+		Data:        []byte("image"),
+	})
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error uploading media: storage error 403: "
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+
+	if stub.report["uploadHttpStatus"] != 403.0 || stub.report["uploadHttpError"] != "storage error 403: " {
+		t.Errorf("Expected failed upload to be reported, got %v", stub.report)
+	}
+}
+
+func TestMediaService_Upload_Validation(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request for invalid upload")
+	}
+
+	client, server := setupMediaTestClient(handler)
+	defer server.Close()
+
+	valid := MediaUpload{TraceID: "t", Field: MediaFieldOutput, ContentType: "image/png", Data: []byte("x")}
+
+	tests := []struct {
+		name          string
+		modify        func(u *MediaUpload)
+		expectedError string
+	}{
+		{"missing trace", func(u *MediaUpload) { u.TraceID = "" }, "error uploading media: trace ID is required"},
+		{"missing type", func(u *MediaUpload) { u.ContentType = "" }, "error uploading media: content type is required"},
+		{"empty data", func(u *MediaUpload) { u.Data = nil }, "error uploading media: data is required"},
+		{"bad field", func(u *MediaUpload) { u.Field = "body" }, `error uploading media: invalid field "body"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upload := valid
+			tt.modify(&upload)
+
+			_, err := client.Media.Upload(context.Background(), upload)
+			if err == nil || err.Error() != tt.expectedError {
+				t.Errorf("Expected error '%s', got '%v'", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestMediaService_Get_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/media/media-1" {
+			t.Errorf("Expected path /api/public/media/media-1, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"mediaId": "media-1", "contentType": "image/png", "contentLength": 1024,
+			"uploadedAt": "2024-01-01T00:00:00Z", "url": "https://storage.example.com/media-1",
+			"urlExpiry": "2024-01-01T01:00:00Z"}`))
+	}
+
+	client, server := setupMediaTestClient(handler)
+	defer server.Close()
+
+	media, err := client.Media.Get(context.Background(), "media-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if media.ContentLength != 1024 || media.URL != "https://storage.example.com/media-1" || media.UploadedAt == nil {
+		t.Errorf("Unexpected media %+v", media)
+	}
+}
@@ -0,0 +1,391 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MetricsService handles queries for aggregated usage, cost and score metrics
+type MetricsService service
+
+// MetricsView represents the data a metrics query aggregates over
+type MetricsView string
+
+// Metrics views
+const (
+	MetricsViewTraces            MetricsView = "traces"
+	MetricsViewObservations      MetricsView = "observations"
+	MetricsViewScoresNumeric     MetricsView = "scores-numeric"
+	MetricsViewScoresCategorical MetricsView = "scores-categorical"
+)
+
+// MetricsAggregation represents how a measure is aggregated
+type MetricsAggregation string
+
+// Metrics aggregations
+const (
+	MetricsAggregationSum   MetricsAggregation = "sum"
+	MetricsAggregationAvg   MetricsAggregation = "avg"
+	MetricsAggregationCount MetricsAggregation = "count"
+	MetricsAggregationMax   MetricsAggregation = "max"
+	MetricsAggregationMin   MetricsAggregation = "min"
+	MetricsAggregationP50   MetricsAggregation = "p50"
+	MetricsAggregationP75   MetricsAggregation = "p75"
+	MetricsAggregationP90   MetricsAggregation = "p90"
+	MetricsAggregationP95   MetricsAggregation = "p95"
+	MetricsAggregationP99   MetricsAggregation = "p99"
+)
+
+// MetricsGranularity represents the bucket size of the time dimension
+type MetricsGranularity string
+
+// Metrics time granularities
+const (
+	MetricsGranularityAuto   MetricsGranularity = "auto"
+	MetricsGranularityMinute MetricsGranularity = "minute"
+	MetricsGranularityHour   MetricsGranularity = "hour"
+	MetricsGranularityDay    MetricsGranularity = "day"
+	MetricsGranularityWeek   MetricsGranularity = "week"
+	MetricsGranularityMonth  MetricsGranularity = "month"
+)
+
+// timeDimensionKey is the row key holding the start of the time bucket
+const timeDimensionKey = "time_dimension"
+
+// metricsAggregations lists the aggregations that prefix metric column names
+var metricsAggregations = []MetricsAggregation{
+	MetricsAggregationSum,
+	MetricsAggregationAvg,
+	MetricsAggregationCount,
+	MetricsAggregationMax,
+	MetricsAggregationMin,
+	MetricsAggregationP50,
+	MetricsAggregationP75,
+	MetricsAggregationP90,
+	MetricsAggregationP95,
+	MetricsAggregationP99,
+}
+
+// MetricsQuery represents a metrics query. Build it with NewMetricsQuery and the
+// chainable methods, or fill the fields directly.
+// https://langfuse.com/docs/metrics/features/metrics-api
+type MetricsQuery struct {
+	View          MetricsView           `json:"view"`
+	Dimensions    []MetricsDimension    `json:"dimensions,omitempty"`
+	Metrics       []MetricsMeasure      `json:"metrics"`
+	Filters       []MetricsFilter       `json:"filters"`
+	TimeDimension *MetricsTimeDimension `json:"timeDimension,omitempty"`
+	FromTimestamp time.Time             `json:"fromTimestamp"`
+	ToTimestamp   time.Time             `json:"toTimestamp"`
+	OrderBy       []MetricsOrder        `json:"orderBy,omitempty"`
+}
+
+// MetricsDimension groups results by a field of the view
+type MetricsDimension struct {
+	Field string `json:"field"`
+}
+
+// MetricsMeasure selects a measure of the view and its aggregation
+type MetricsMeasure struct {
+	Measure     string             `json:"measure"`
+	Aggregation MetricsAggregation `json:"aggregation"`
+}
+
+// MetricsFilter restricts the rows a query aggregates over. Key is only used for
+// metadata filters.
+type MetricsFilter struct {
+	Column   string      `json:"column"`
+	Operator string      `json:"operator"`
+	Value    interface{} `json:"value"`
+	Type     string      `json:"type"`
+	Key      string      `json:"key,omitempty"`
+}
+
+// MetricsTimeDimension buckets results by time
+type MetricsTimeDimension struct {
+	Granularity MetricsGranularity `json:"granularity"`
+}
+
+// MetricsOrder orders results by a dimension or metric. Direction is "asc" or "desc".
+type MetricsOrder struct {
+	Field     string `json:"field"`
+	Direction string `json:"direction"`
+}
+
+// MetricsResult represents the rows returned by a metrics query
+type MetricsResult struct {
+	Data []MetricsRow `json:"data"`
+}
+
+// MetricsRow represents a single result row. Dimensions are keyed by field name
+// and Metrics by column name ("<aggregation>_<measure>", for example
+// "sum_totalCost"). Time holds the start of the row's time bucket and is nil if
+// the query had no time dimension.
+type MetricsRow struct {
+	Time       *time.Time
+	Dimensions map[string]string
+	Metrics    map[string]float64
+}
+
+// DailyMetricsFilter holds the query parameters for daily metrics. Zero values are omitted.
+type DailyMetricsFilter struct {
+	Page          int
+	Limit         int
+	TraceName     string
+	UserID        string
+	Tags          []string
+	Environment   string
+	FromTimestamp time.Time
+	ToTimestamp   time.Time
+}
+
+// DailyMetrics represents the usage and cost of a single day
+type DailyMetrics struct {
+	Date              string              `json:"date"`
+	CountTraces       int                 `json:"countTraces"`
+	CountObservations int                 `json:"countObservations"`
+	TotalCost         float64             `json:"totalCost"`
+	Usage             []DailyModelMetrics `json:"usage"`
+}
+
+// DailyModelMetrics represents the usage and cost of a single model on a day
+type DailyModelMetrics struct {
+	Model             string  `json:"model"`
+	InputUsage        int     `json:"inputUsage"`
+	OutputUsage       int     `json:"outputUsage"`
+	TotalUsage        int     `json:"totalUsage"`
+	CountTraces       int     `json:"countTraces"`
+	CountObservations int     `json:"countObservations"`
+	TotalCost         float64 `json:"totalCost"`
+}
+
+// DailyMetricsList represents a page of daily metrics, most recent day first
+type DailyMetricsList struct {
+	Data []DailyMetrics `json:"data"`
+	Meta MetaResponse   `json:"meta"`
+}
+
+// NewMetricsQuery creates a query over the given view
+func NewMetricsQuery(view MetricsView) *MetricsQuery {
+	return &MetricsQuery{View: view}
+}
+
+// Dimension adds a field to group results by
+func (q *MetricsQuery) Dimension(field string) *MetricsQuery {
+	q.Dimensions = append(q.Dimensions, MetricsDimension{Field: field})
+	return q
+}
+
+// Metric adds an aggregated measure to the results
+func (q *MetricsQuery) Metric(measure string, aggregation MetricsAggregation) *MetricsQuery {
+	q.Metrics = append(q.Metrics, MetricsMeasure{Measure: measure, Aggregation: aggregation})
+	return q
+}
+
+// Filter adds a filter on a column. The filter type is derived from the value:
+// strings, numbers, booleans, times and string slices are supported.
+func (q *MetricsQuery) Filter(column, operator string, value interface{}) *MetricsQuery {
+	filterType := "string"
+	switch v := value.(type) {
+	case []string:
+		filterType = "stringOptions"
+	case time.Time:
+		filterType = "datetime"
+		value = v.UTC()
+	case bool:
+		filterType = "boolean"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		filterType = "number"
+	}
+
+	q.Filters = append(q.Filters, MetricsFilter{Column: column, Operator: operator, Value: value, Type: filterType})
+	return q
+}
+
+// MetadataFilter adds a filter on a metadata key
+func (q *MetricsQuery) MetadataFilter(key, operator, value string) *MetricsQuery {
+	q.Filters = append(q.Filters, MetricsFilter{
+		Column:   "metadata",
+		Operator: operator,
+		Value:    value,
+		Type:     "stringObject",
+		Key:      key,
+	})
+	return q
+}
+
+// Granularity buckets results by time. Rows then carry the bucket start in MetricsRow.Time.
+func (q *MetricsQuery) Granularity(granularity MetricsGranularity) *MetricsQuery {
+	q.TimeDimension = &MetricsTimeDimension{Granularity: granularity}
+	return q
+}
+
+// Between restricts the query to the time range [from, to)
+func (q *MetricsQuery) Between(from, to time.Time) *MetricsQuery {
+	q.FromTimestamp = from.UTC()
+	q.ToTimestamp = to.UTC()
+	return q
+}
+
+// Order adds an ordering on a dimension or metric column
+func (q *MetricsQuery) Order(field, direction string) *MetricsQuery {
+	q.OrderBy = append(q.OrderBy, MetricsOrder{Field: field, Direction: direction})
+	return q
+}
+
+func (q *MetricsQuery) validate() error {
+	if q.View == "" {
+		return errors.New("view is required")
+	}
+	if len(q.Metrics) == 0 {
+		return errors.New("at least one metric is required")
+	}
+	if q.FromTimestamp.IsZero() || q.ToTimestamp.IsZero() {
+		return errors.New("time range is required")
+	}
+	if !q.FromTimestamp.Before(q.ToTimestamp) {
+		return errors.New("from timestamp must be before to timestamp")
+	}
+	return nil
+}
+
+// Metric returns the value of an aggregated measure, or 0 if the row has none
+func (r MetricsRow) Metric(measure string, aggregation MetricsAggregation) float64 {
+	return r.Metrics[string(aggregation)+"_"+measure]
+}
+
+// UnmarshalJSON decodes a Langfuse result row. Columns named after an
+// aggregation are metrics; Langfuse returns some of them as strings, which are
+// parsed. All other columns are dimensions. Null metrics are omitted.
+func (r *MetricsRow) UnmarshalJSON(data []byte) error {
+	var columns map[string]interface{}
+	if err := json.Unmarshal(data, &columns); err != nil {
+		return fmt.Errorf("error unmarshalling metrics row: %w", err)
+	}
+
+	*r = MetricsRow{Dimensions: map[string]string{}, Metrics: map[string]float64{}}
+	for k, v := range columns {
+		switch {
+		case k == timeDimensionKey:
+			s, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("error unmarshalling metrics row: invalid time dimension %v", v)
+			}
+			t, err := time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				return fmt.Errorf("error unmarshalling metrics row: %w", err)
+			}
+			r.Time = &t
+		case isMetricColumn(k):
+			if v == nil {
+				continue
+			}
+			f, err := metricValue(v)
+			if err != nil {
+				return fmt.Errorf("error unmarshalling metrics row: column %s: %w", k, err)
+			}
+			r.Metrics[k] = f
+		default:
+			if v == nil {
+				r.Dimensions[k] = ""
+				continue
+			}
+			if s, ok := v.(string); ok {
+				r.Dimensions[k] = s
+				continue
+			}
+			r.Dimensions[k] = fmt.Sprint(v)
+		}
+	}
+
+	return nil
+}
+
+func isMetricColumn(column string) bool {
+	for _, aggregation := range metricsAggregations {
+		if strings.HasPrefix(column, string(aggregation)+"_") {
+			return true
+		}
+	}
+	return false
+}
+
+func metricValue(value interface{}) (float64, error) {
+	switch v := value.(type) {
+	case float64:
+		return v, nil
+	case string:
+		return strconv.ParseFloat(v, 64)
+	default:
+		return 0, fmt.Errorf("unexpected value %v", v)
+	}
+}
+
+// Query runs a metrics query
+// https://api.reference.langfuse.com/#tag/metrics/get/api/public/metrics
+func (s *MetricsService) Query(ctx context.Context, query *MetricsQuery) (*MetricsResult, error) {
+	if err := query.validate(); err != nil {
+		return nil, fmt.Errorf("error querying metrics: %w", err)
+	}
+
+	// The API expects an empty filter list rather than null
+	q := *query
+	if q.Filters == nil {
+		q.Filters = []MetricsFilter{}
+	}
+
+	encoded, err := json.Marshal(&q)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling metrics query: %w", err)
+	}
+
+	u := withQuery("/api/public/metrics", url.Values{"query": {string(encoded)}})
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error querying metrics: %w", err)
+	}
+
+	var result MetricsResult
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling metrics data: %w", err)
+	}
+
+	return &result, nil
+}
+
+// Daily retrieves a page of daily usage and cost metrics
+// https://api.reference.langfuse.com/#tag/metrics/get/api/public/metrics/daily
+func (s *MetricsService) Daily(ctx context.Context, filter DailyMetricsFilter) (*DailyMetricsList, error) {
+	params := url.Values{}
+	setInt(params, "page", filter.Page)
+	setInt(params, "limit", filter.Limit)
+	setString(params, "traceName", filter.TraceName)
+	setString(params, "userId", filter.UserID)
+	addStrings(params, "tags", filter.Tags)
+	setString(params, "environment", filter.Environment)
+	setTime(params, "fromTimestamp", filter.FromTimestamp)
+	setTime(params, "toTimestamp", filter.ToTimestamp)
+
+	u := withQuery("/api/public/metrics/daily", params)
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching daily metrics: %w", err)
+	}
+
+	var metrics DailyMetricsList
+	err = json.Unmarshal(body, &metrics)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling daily metrics data: %w", err)
+	}
+
+	return &metrics, nil
+}
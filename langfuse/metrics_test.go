@@ -0,0 +1,259 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupMetricsTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.Metrics = (*MetricsService)(&service{client: client})
+
+	return client, server
+}
+
+func TestMetricsService_Query_Success(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/metrics" {
+			t.Errorf("Expected path /api/public/metrics, got %s", r.URL.Path)
+		}
+
+		var query map[string]interface{}
+		if err := json.Unmarshal([]byte(r.URL.Query().Get("query")), &query); err != nil {
+			t.Fatalf("Failed to decode query parameter: %v", err)
+		}
+
+		expected := map[string]interface{}{
+			"view": "observations",
+			"dimensions": []interface{}{
+				map[string]interface{}{"field": "userId"},
+				map[string]interface{}{"field": "providedModelName"},
+			},
+			"metrics": []interface{}{
+				map[string]interface{}{"measure": "totalCost", "aggregation": "sum"},
+				map[string]interface{}{"measure": "totalTokens", "aggregation": "sum"},
+			},
+			"filters": []interface{}{
+				map[string]interface{}{"column": "environment", "operator": "=", "value": "production", "type": "string"},
+				map[string]interface{}{"column": "tags", "operator": "any of", "value": []interface{}{"billing"},
+					"type": "stringOptions"},
+				map[string]interface{}{"column": "metadata", "operator": "=", "value": "acme", "type": "stringObject",
+					"key": "tenant"},
+			},
+			"timeDimension": map[string]interface{}{"granularity": "day"},
+			"fromTimestamp": "2024-01-01T00:00:00Z",
+			"toTimestamp":   "2024-02-01T00:00:00Z",
+			"orderBy":       []interface{}{map[string]interface{}{"field": "sum_totalCost", "direction": "desc"}},
+		}
+		if !reflect.DeepEqual(query, expected) {
+			t.Errorf("Unexpected query\n got: %v\nwant: %v", query, expected)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [
+			{"userId": "u1", "providedModelName": "gpt-4o", "sum_totalCost": 1.25, "sum_totalTokens": "5000",
+				"time_dimension": "2024-01-02T00:00:00.000Z"}
+		]}`))
+	}
+
+	client, server := setupMetricsTestClient(handler)
+	defer server.Close()
+
+	query := NewMetricsQuery(MetricsViewObservations).
+		Dimension("userId").
+		Dimension("providedModelName").
+		Metric("totalCost", MetricsAggregationSum).
+		Metric("totalTokens", MetricsAggregationSum).
+		Filter("environment", "=", "production").
+		Filter("tags", "any of", []string{"billing"}).
+		MetadataFilter("tenant", "=", "acme").
+		Granularity(MetricsGranularityDay).
+		Between(from, to).
+		Order("sum_totalCost", "desc")
+
+	result, err := client.Metrics.Query(context.Background(), query)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(result.Data) != 1 {
+		t.Fatalf("Expected 1 row, got %d", len(result.Data))
+	}
+
+	row := result.Data[0]
+	if row.Dimensions["userId"] != "u1" || row.Dimensions["providedModelName"] != "gpt-4o" ||
+		len(row.Dimensions) != 2 {
+		t.Errorf("Unexpected dimensions %v", row.Dimensions)
+	}
+	if row.Metric("totalCost", MetricsAggregationSum) != 1.25 {
+		t.Errorf("Expected cost 1.25, got %v", row.Metric("totalCost", MetricsAggregationSum))
+	}
+	if row.Metric("totalTokens", MetricsAggregationSum) != 5000 {
+		t.Errorf("Expected tokens 5000, got %v", row.Metric("totalTokens", MetricsAggregationSum))
+	}
+
+	if row.Time == nil || !row.Time.Equal(from.AddDate(0, 0, 1)) {
+		t.Errorf("Expected time dimension 2024-01-02, got %v", row.Time)
+	}
+}
+
+func TestMetricsService_Query_FilterTypes(t *testing.T) {
+	at := time.Date(2024, 1, 1, 12, 0, 0, 0, time.FixedZone("CET", 3600))
+
+	query := NewMetricsQuery(MetricsViewTraces).
+		Filter("name", "=", "chat").
+		Filter("latency", ">", 1.5).
+		Filter("count", ">=", 3).
+		Filter("bookmarked", "=", true).
+		Filter("timestamp", "<", at).
+		Filter("level", "=", int8(2)).
+		Filter("tokens", ">", uint64(100))
+
+	expected := []string{"string", "number", "number", "boolean", "datetime", "number", "number"}
+	for i, filter := range query.Filters {
+		if filter.Type != expected[i] {
+			t.Errorf("Expected filter %s to have type %s, got %s", filter.Column, expected[i], filter.Type)
+		}
+	}
+
+	if value := query.Filters[4].Value.(time.Time); value.Location() != time.UTC {
+		t.Errorf("Expected datetime filter in UTC, got %v", value)
+	}
+}
+
+func TestMetricsService_Query_Validation(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request for invalid query")
+	}
+
+	client, server := setupMetricsTestClient(handler)
+	defer server.Close()
+
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		query         *MetricsQuery
+		expectedError string
+	}{
+		{
+			name:          "missing view",
+			query:         NewMetricsQuery("").Metric("count", MetricsAggregationCount).Between(from, from.Add(time.Hour)),
+			expectedError: "error querying metrics: view is required",
+		},
+		{
+			name:          "missing metric",
+			query:         NewMetricsQuery(MetricsViewTraces).Between(from, from.Add(time.Hour)),
+			expectedError: "error querying metrics: at least one metric is required",
+		},
+		{
+			name:          "missing range",
+			query:         NewMetricsQuery(MetricsViewTraces).Metric("count", MetricsAggregationCount),
+			expectedError: "error querying metrics: time range is required",
+		},
+		{
+			name:          "inverted range",
+			query:         NewMetricsQuery(MetricsViewTraces).Metric("count", MetricsAggregationCount).Between(from, from),
+			expectedError: "error querying metrics: from timestamp must be before to timestamp",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.Metrics.Query(context.Background(), tt.query)
+			if err == nil || err.Error() != tt.expectedError {
+				t.Errorf("Expected error '%s', got '%v'", tt.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestMetricsRow_UnmarshalJSON(t *testing.T) {
+	var row MetricsRow
+	err := json.Unmarshal([]byte(`{"name": "chat", "version": 2, "count_count": "3", "avg_latency": null}`), &row)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if row.Time != nil {
+		t.Errorf("Expected no time dimension, got %v", row.Time)
+	}
+	if !reflect.DeepEqual(row.Dimensions, map[string]string{"name": "chat", "version": "2"}) {
+		t.Errorf("Unexpected dimensions %v", row.Dimensions)
+	}
+	if !reflect.DeepEqual(row.Metrics, map[string]float64{"count_count": 3}) {
+		t.Errorf("Unexpected metrics %v", row.Metrics)
+	}
+
+	err = json.Unmarshal([]byte(`{"sum_totalCost": "n/a"}`), &row)
+	expectedError := `error unmarshalling metrics row: column sum_totalCost: strconv.ParseFloat: parsing "n/a": ` +
+		`invalid syntax`
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%v'", expectedError, err)
+	}
+}
+
+func TestMetricsService_Daily_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/metrics/daily" {
+			t.Errorf("Expected path /api/public/metrics/daily, got %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		expected := map[string][]string{
+			"userId":        {"u1"},
+			"tags":          {"billing", "prod"},
+			"fromTimestamp": {"2024-01-01T00:00:00Z"},
+		}
+		for key, values := range expected {
+			if !reflect.DeepEqual(query[key], values) {
+				t.Errorf("Expected %s=%v, got %v", key, values, query[key])
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [{"date": "2024-01-02", "countTraces": 10, "countObservations": 30, "totalCost": 0.5,
+			"usage": [{"model": "gpt-4o", "inputUsage": 1000, "outputUsage": 200, "totalUsage": 1200,
+				"countTraces": 10, "countObservations": 10, "totalCost": 0.5}]}],
+			"meta": {"page": 1, "limit": 50, "totalItems": 1, "totalPages": 1}}`))
+	}
+
+	client, server := setupMetricsTestClient(handler)
+	defer server.Close()
+
+	metrics, err := client.Metrics.Daily(context.Background(), DailyMetricsFilter{
+		UserID:        "u1",
+		Tags:          []string{"billing", "prod"},
+		FromTimestamp: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(metrics.Data) != 1 || len(metrics.Data[0].Usage) != 1 || metrics.Data[0].Usage[0].TotalUsage != 1200 {
+		t.Errorf("Unexpected daily metrics %+v", metrics.Data)
+	}
+}
@@ -0,0 +1,164 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"time"
+)
+
+// ModelsService handles operations related to model definitions used for cost calculation
+type ModelsService service
+
+// ModelUsageUnit represents the unit a model's prices are defined in
+type ModelUsageUnit string
+
+// Model usage units
+const (
+	ModelUsageUnitCharacters   ModelUsageUnit = "CHARACTERS"
+	ModelUsageUnitTokens       ModelUsageUnit = "TOKENS"
+	ModelUsageUnitMilliseconds ModelUsageUnit = "MILLISECONDS"
+	ModelUsageUnitSeconds      ModelUsageUnit = "SECONDS"
+	ModelUsageUnitImages       ModelUsageUnit = "IMAGES"
+	ModelUsageUnitRequests     ModelUsageUnit = "REQUESTS"
+)
+
+// Model represents a model definition. MatchPattern is a regular expression
+// matched against the model name of generations. Prices are per unit in USD.
+type Model struct {
+	ID                string                 `json:"id,omitempty"`
+	ModelName         string                 `json:"modelName"`
+	MatchPattern      string                 `json:"matchPattern"`
+	StartDate         *time.Time             `json:"startDate,omitempty"`
+	Unit              ModelUsageUnit         `json:"unit,omitempty"`
+	InputPrice        *float64               `json:"inputPrice,omitempty"`
+	OutputPrice       *float64               `json:"outputPrice,omitempty"`
+	TotalPrice        *float64               `json:"totalPrice,omitempty"`
+	TokenizerID       string                 `json:"tokenizerId,omitempty"`
+	TokenizerConfig   map[string]interface{} `json:"tokenizerConfig,omitempty"`
+	IsLangfuseManaged bool                   `json:"isLangfuseManaged,omitempty"`
+	Prices            map[string]ModelPrice  `json:"prices,omitempty"`
+	PricingTiers      []PricingTier          `json:"pricingTiers,omitempty"`
+}
+
+// ModelPrice represents the price of a single usage type
+type ModelPrice struct {
+	Price float64 `json:"price"`
+}
+
+// PricingTier represents a set of prices that applies when all of its conditions
+// match a generation's usage details. The default tier applies otherwise.
+type PricingTier struct {
+	ID         string             `json:"id,omitempty"`
+	Name       string             `json:"name"`
+	IsDefault  bool               `json:"isDefault"`
+	Priority   int                `json:"priority"`
+	Conditions []PricingCondition `json:"conditions"`
+	Prices     map[string]float64 `json:"prices"`
+}
+
+// PricingCondition compares the sum of the usage details matching UsageDetailPattern with Value
+type PricingCondition struct {
+	UsageDetailPattern string  `json:"usageDetailPattern"`
+	Operator           string  `json:"operator"`
+	Value              float64 `json:"value"`
+	CaseSensitive      bool    `json:"caseSensitive,omitempty"`
+}
+
+// ModelList represents a page of model definitions
+type ModelList struct {
+	Data []Model      `json:"data"`
+	Meta MetaResponse `json:"meta"`
+}
+
+// Create creates a new model definition
+// https://api.reference.langfuse.com/#tag/models/post/api/public/models
+func (s *ModelsService) Create(ctx context.Context, model Model) (*Model, error) {
+	if model.ModelName == "" || model.MatchPattern == "" {
+		return nil, fmt.Errorf("error creating model: model name and match pattern are required")
+	}
+
+	u := "/api/public/models"
+
+	body, err := s.client.DoWithContext(ctx, "POST", u, &model)
+	if err != nil {
+		return nil, fmt.Errorf("error creating model: %w", err)
+	}
+
+	var created Model
+	err = json.Unmarshal(body, &created)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling created model data: %w", err)
+	}
+
+	return &created, nil
+}
+
+// Get retrieves a model definition by its ID
+// https://api.reference.langfuse.com/#tag/models/get/api/public/models/{id}
+func (s *ModelsService) Get(ctx context.Context, id string) (*Model, error) {
+	u := fmt.Sprintf("/api/public/models/%s", url.PathEscape(id))
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching model: %w", err)
+	}
+
+	var model Model
+	err = json.Unmarshal(body, &model)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling model data: %w", err)
+	}
+
+	return &model, nil
+}
+
+// List retrieves a page of model definitions, including those managed by Langfuse.
+// Zero page and limit use the server defaults.
+// https://api.reference.langfuse.com/#tag/models/get/api/public/models
+func (s *ModelsService) List(ctx context.Context, page, limit int) (*ModelList, error) {
+	params := url.Values{}
+	setInt(params, "page", page)
+	setInt(params, "limit", limit)
+
+	u := withQuery("/api/public/models", params)
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing models: %w", err)
+	}
+
+	var models ModelList
+	err = json.Unmarshal(body, &models)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling models data: %w", err)
+	}
+
+	return &models, nil
+}
+
+// All iterates over all model definitions, fetching pages as needed
+func (s *ModelsService) All(ctx context.Context) iter.Seq2[Model, error] {
+	return paginate(ctx, 1, func(ctx context.Context, page int) ([]Model, *MetaResponse, error) {
+		models, err := s.List(ctx, page, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		return models.Data, &models.Meta, nil
+	})
+}
+
+// Delete deletes a model definition. Models managed by Langfuse cannot be deleted.
+// https://api.reference.langfuse.com/#tag/models/delete/api/public/models/{id}
+func (s *ModelsService) Delete(ctx context.Context, id string) error {
+	u := fmt.Sprintf("/api/public/models/%s", url.PathEscape(id))
+
+	_, err := s.client.DoWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting model: %w", err)
+	}
+
+	return nil
+}
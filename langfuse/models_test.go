@@ -0,0 +1,206 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupModelsTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.Models = (*ModelsService)(&service{client: client})
+
+	return client, server
+}
+
+func TestModelsService_Create_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/api/public/models" {
+			t.Errorf("Expected path /api/public/models, got %s", r.URL.Path)
+		}
+
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		expected := map[string]interface{}{
+			"modelName":    "llama-ft",
+			"matchPattern": "(?i)^(llama-ft)$",
+			"unit":         "TOKENS",
+			"inputPrice":   0.000001,
+			"outputPrice":  0.000002,
+			"tokenizerId":  "openai",
+		}
+		for key, value := range expected {
+			if payload[key] != value {
+				t.Errorf("Expected %s=%v, got %v", key, value, payload[key])
+			}
+		}
+		if _, ok := payload["totalPrice"]; ok {
+			t.Errorf("Expected totalPrice to be omitted, got %v", payload["totalPrice"])
+		}
+
+		tiers, _ := payload["pricingTiers"].([]interface{})
+		if len(tiers) != 1 {
+			t.Fatalf("Expected 1 pricing tier, got %v", payload["pricingTiers"])
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"id": "model-1",
+			"modelName": "llama-ft",
+			"matchPattern": "(?i)^(llama-ft)$",
+			"unit": "TOKENS",
+			"inputPrice": 0.000001,
+			"outputPrice": 0.000002,
+			"isLangfuseManaged": false,
+			"prices": {"input": {"price": 0.000001}, "output": {"price": 0.000002}},
+			"pricingTiers": [{"id": "tier-1", "name": "Standard", "isDefault": true, "priority": 0,
+				"conditions": [], "prices": {"input": 0.000001, "output": 0.000002}}]
+		}`))
+	}
+
+	client, server := setupModelsTestClient(handler)
+	defer server.Close()
+
+	inputPrice, outputPrice := 0.000001, 0.000002
+	model, err := client.Models.Create(context.Background(), Model{
+		ModelName:    "llama-ft",
+		MatchPattern: "(?i)^(llama-ft)$",
+		Unit:         ModelUsageUnitTokens,
+		InputPrice:   &inputPrice,
+		OutputPrice:  &outputPrice,
+		TokenizerID:  "openai",
+		PricingTiers: []PricingTier{{
+			Name:       "Standard",
+			IsDefault:  true,
+			Conditions: []PricingCondition{},
+			Prices:     map[string]float64{"input": inputPrice, "output": outputPrice},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if model.ID != "model-1" || model.Prices["output"].Price != outputPrice {
+		t.Errorf("Unexpected model %+v", model)
+	}
+
+	if len(model.PricingTiers) != 1 || !model.PricingTiers[0].IsDefault {
+		t.Errorf("Unexpected pricing tiers %+v", model.PricingTiers)
+	}
+}
+
+func TestModelsService_Create_RequiredFields(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request for invalid model")
+	}
+
+	client, server := setupModelsTestClient(handler)
+	defer server.Close()
+
+	_, err := client.Models.Create(context.Background(), Model{ModelName: "llama-ft"})
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error creating model: model name and match pattern are required"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
+
+func TestModelsService_Get_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/models/model-1" {
+			t.Errorf("Expected path /api/public/models/model-1, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "model-1", "modelName": "gpt-4o", "matchPattern": "(?i)^(gpt-4o)$",
+			"isLangfuseManaged": true, "startDate": "2024-05-13T00:00:00Z", "tokenizerConfig": {"tokensPerName": 1}}`))
+	}
+
+	client, server := setupModelsTestClient(handler)
+	defer server.Close()
+
+	model, err := client.Models.Get(context.Background(), "model-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !model.IsLangfuseManaged || model.StartDate == nil || model.TokenizerConfig["tokensPerName"] != 1.0 {
+		t.Errorf("Unexpected model %+v", model)
+	}
+}
+
+func TestModelsService_List_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") != "1" || r.URL.Query().Get("limit") != "100" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [{"id": "model-1", "modelName": "gpt-4o"}, {"id": "model-2", "modelName": "llama-ft"}],
+			"meta": {"page": 1, "limit": 100, "totalItems": 2, "totalPages": 1}}`))
+	}
+
+	client, server := setupModelsTestClient(handler)
+	defer server.Close()
+
+	models, err := client.Models.List(context.Background(), 1, 100)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(models.Data) != 2 || models.Data[1].ModelName != "llama-ft" {
+		t.Errorf("Unexpected models %+v", models.Data)
+	}
+}
+
+func TestModelsService_Delete_Error(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Expected DELETE method, got %s", r.Method)
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("not found"))
+	}
+
+	client, server := setupModelsTestClient(handler)
+	defer server.Close()
+
+	err := client.Models.Delete(context.Background(), "model-1")
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error deleting model: client error 404: not found"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
@@ -0,0 +1,146 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"time"
+)
+
+// ObservationsService handles operations related to observations
+type ObservationsService service
+
+// ObservationType represents the type of an observation
+type ObservationType string
+
+// Observation types
+const (
+	ObservationTypeSpan       ObservationType = "SPAN"
+	ObservationTypeGeneration ObservationType = "GENERATION"
+	ObservationTypeEvent      ObservationType = "EVENT"
+)
+
+// Observation represents a span, generation or event recorded within a trace.
+// The model, usage, cost and prompt fields are only populated for generations;
+// Latency and TimeToFirstToken are reported in seconds.
+type Observation struct {
+	ID                  string                 `json:"id"`
+	TraceID             string                 `json:"traceId,omitempty"`
+	Type                ObservationType        `json:"type"`
+	Name                string                 `json:"name,omitempty"`
+	StartTime           time.Time              `json:"startTime"`
+	EndTime             *time.Time             `json:"endTime,omitempty"`
+	ParentObservationID string                 `json:"parentObservationId,omitempty"`
+	Level               ObservationLevel       `json:"level,omitempty"`
+	StatusMessage       string                 `json:"statusMessage,omitempty"`
+	Input               interface{}            `json:"input,omitempty"`
+	Output              interface{}            `json:"output,omitempty"`
+	Metadata            map[string]interface{} `json:"metadata,omitempty"`
+	Version             string                 `json:"version,omitempty"`
+	Environment         string                 `json:"environment,omitempty"`
+	Latency             float64                `json:"latency,omitempty"`
+
+	// Generation fields
+	CompletionStartTime *time.Time             `json:"completionStartTime,omitempty"`
+	Model               string                 `json:"model,omitempty"`
+	ModelID             string                 `json:"modelId,omitempty"`
+	ModelParameters     map[string]interface{} `json:"modelParameters,omitempty"`
+	UsageDetails        *Usage                 `json:"usageDetails,omitempty"`
+	CostDetails         *Cost                  `json:"costDetails,omitempty"`
+	PromptID            string                 `json:"promptId,omitempty"`
+	PromptName          string                 `json:"promptName,omitempty"`
+	PromptVersion       int                    `json:"promptVersion,omitempty"`
+	TimeToFirstToken    float64                `json:"timeToFirstToken,omitempty"`
+}
+
+// ObservationList represents a page of observations
+type ObservationList struct {
+	Data []Observation `json:"data"`
+	Meta MetaResponse  `json:"meta"`
+}
+
+// ObservationFilter holds the query parameters for listing observations. Zero values are omitted.
+type ObservationFilter struct {
+	Page                int
+	Limit               int
+	TraceID             string
+	Type                ObservationType
+	Name                string
+	UserID              string
+	ParentObservationID string
+	Level               ObservationLevel
+	Environment         []string
+	Version             string
+	FromStartTime       time.Time
+	ToStartTime         time.Time
+}
+
+// Get retrieves a single observation
+// https://api.reference.langfuse.com/#tag/observations/get/api/public/observations/{observationId}
+func (s *ObservationsService) Get(ctx context.Context, id string) (*Observation, error) {
+	u := fmt.Sprintf("/api/public/observations/%s", url.PathEscape(id))
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching observation: %w", err)
+	}
+
+	var observation Observation
+	err = json.Unmarshal(body, &observation)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling observation data: %w", err)
+	}
+
+	return &observation, nil
+}
+
+// List retrieves a page of observations matching the filter
+// https://api.reference.langfuse.com/#tag/observations/get/api/public/observations
+func (s *ObservationsService) List(ctx context.Context, filter ObservationFilter) (*ObservationList, error) {
+	u := withQuery("/api/public/observations", filter.values())
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing observations: %w", err)
+	}
+
+	var observations ObservationList
+	err = json.Unmarshal(body, &observations)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling observations data: %w", err)
+	}
+
+	return &observations, nil
+}
+
+// All iterates over all observations matching the filter, fetching pages as
+// needed starting at filter.Page
+func (s *ObservationsService) All(ctx context.Context, filter ObservationFilter) iter.Seq2[Observation, error] {
+	return paginate(ctx, filter.Page, func(ctx context.Context, page int) ([]Observation, *MetaResponse, error) {
+		filter.Page = page
+		observations, err := s.List(ctx, filter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return observations.Data, &observations.Meta, nil
+	})
+}
+
+func (f *ObservationFilter) values() url.Values {
+	params := url.Values{}
+	setInt(params, "page", f.Page)
+	setInt(params, "limit", f.Limit)
+	setString(params, "traceId", f.TraceID)
+	setString(params, "type", string(f.Type))
+	setString(params, "name", f.Name)
+	setString(params, "userId", f.UserID)
+	setString(params, "parentObservationId", f.ParentObservationID)
+	setString(params, "level", string(f.Level))
+	addStrings(params, "environment", f.Environment)
+	setString(params, "version", f.Version)
+	setTime(params, "fromStartTime", f.FromStartTime)
+	setTime(params, "toStartTime", f.ToStartTime)
+	return params
+}
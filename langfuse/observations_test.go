@@ -0,0 +1,198 @@
+package langfuse
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupObservationsTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.Observations = (*ObservationsService)(&service{client: client})
+
+	return client, server
+}
+
+func TestObservationsService_Get_Generation(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("Expected GET method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/api/public/observations/obs-1" {
+			t.Errorf("Expected path /api/public/observations/obs-1, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"id": "obs-1",
+			"traceId": "trace-1",
+			"type": "GENERATION",
+			"name": "completion",
+			"startTime": "2024-01-01T00:00:00Z",
+			"endTime": "2024-01-01T00:00:02Z",
+			"completionStartTime": "2024-01-01T00:00:00.5Z",
+			"model": "gpt-4o",
+			"modelParameters": {"temperature": 0.2, "max_tokens": 100},
+			"usageDetails": {"input": 120, "output": 30, "total": 150, "input_cached_tokens": 100},
+			"costDetails": {"input": 0.0012, "output": 0.0009, "total": 0.0021},
+			"promptId": "prompt-1",
+			"promptName": "chat-assistant",
+			"promptVersion": 3,
+			"latency": 2,
+			"timeToFirstToken": 0.5,
+			"level": "DEFAULT"
+		}`))
+	}
+
+	client, server := setupObservationsTestClient(handler)
+	defer server.Close()
+
+	observation, err := client.Observations.Get(context.Background(), "obs-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if observation.Type != ObservationTypeGeneration || observation.Model != "gpt-4o" {
+		t.Errorf("Unexpected observation %+v", observation)
+	}
+
+	if observation.UsageDetails == nil || observation.UsageDetails.Total != 150 || observation.UsageDetails.Cached != 100 {
+		t.Errorf("Unexpected usage %+v", observation.UsageDetails)
+	}
+
+	if observation.CostDetails == nil || observation.CostDetails.Total != 0.0021 {
+		t.Errorf("Unexpected cost %+v", observation.CostDetails)
+	}
+
+	if observation.PromptName != "chat-assistant" || observation.PromptVersion != 3 {
+		t.Errorf("Unexpected prompt link %s v%d", observation.PromptName, observation.PromptVersion)
+	}
+
+	if observation.TimeToFirstToken != 0.5 || observation.Latency != 2 {
+		t.Errorf("Unexpected timings ttft=%v latency=%v", observation.TimeToFirstToken, observation.Latency)
+	}
+
+	if observation.ModelParameters["temperature"] != 0.2 {
+		t.Errorf("Unexpected model parameters %v", observation.ModelParameters)
+	}
+}
+
+func TestObservationsService_Get_Error(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("not found"))
+	}
+
+	client, server := setupObservationsTestClient(handler)
+	defer server.Close()
+
+	_, err := client.Observations.Get(context.Background(), "missing")
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error fetching observation: client error 404: not found"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
+
+func TestObservationsService_List_Filter(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/observations" {
+			t.Errorf("Expected path /api/public/observations, got %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		expected := map[string][]string{
+			"traceId":             {"trace-1"},
+			"type":                {"SPAN"},
+			"name":                {"retrieval"},
+			"parentObservationId": {"obs-0"},
+			"level":               {"ERROR"},
+			"fromStartTime":       {"2024-01-01T00:00:00Z"},
+			"toStartTime":         {"2024-01-01T01:00:00Z"},
+			"limit":               {"20"},
+		}
+		for key, values := range expected {
+			if !reflect.DeepEqual(query[key], values) {
+				t.Errorf("Expected %s=%v, got %v", key, values, query[key])
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"data": [{"id": "obs-1", "type": "SPAN", "startTime": "2024-01-01T00:10:00Z"}],
+			"meta": {"page": 1, "limit": 20, "totalItems": 1, "totalPages": 1}
+		}`))
+	}
+
+	client, server := setupObservationsTestClient(handler)
+	defer server.Close()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	observations, err := client.Observations.List(context.Background(), ObservationFilter{
+		TraceID:             "trace-1",
+		Type:                ObservationTypeSpan,
+		Name:                "retrieval",
+		ParentObservationID: "obs-0",
+		Level:               ObservationLevelError,
+		FromStartTime:       start,
+		ToStartTime:         start.Add(time.Hour),
+		Limit:               20,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(observations.Data) != 1 || observations.Data[0].UsageDetails != nil {
+		t.Errorf("Unexpected observations %+v", observations.Data)
+	}
+}
+
+func TestObservationsService_All_Paginates(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{
+			"data": [{"id": "obs-%s", "type": "EVENT"}],
+			"meta": {"page": %s, "limit": 1, "totalItems": 2, "totalPages": 2}
+		}`, page, page)
+	}
+
+	client, server := setupObservationsTestClient(handler)
+	defer server.Close()
+
+	var ids []string
+	for observation, err := range client.Observations.All(context.Background(), ObservationFilter{TraceID: "t"}) {
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		ids = append(ids, observation.ID)
+	}
+
+	if !reflect.DeepEqual(ids, []string{"obs-1", "obs-2"}) {
+		t.Errorf("Expected [obs-1 obs-2], got %v", ids)
+	}
+}
@@ -0,0 +1,323 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// OrganizationsService handles organization administration: projects, memberships
+// and project API keys. It requires a client configured with an organization-scoped API key.
+type OrganizationsService service
+
+// MembershipRole represents the role of a user in an organization or project
+type MembershipRole string
+
+// Membership roles
+const (
+	MembershipRoleOwner  MembershipRole = "OWNER"
+	MembershipRoleAdmin  MembershipRole = "ADMIN"
+	MembershipRoleMember MembershipRole = "MEMBER"
+	MembershipRoleViewer MembershipRole = "VIEWER"
+	MembershipRoleNone   MembershipRole = "NONE"
+)
+
+// Project represents a project of an organization
+type Project struct {
+	ID            string                 `json:"id"`
+	Name          string                 `json:"name"`
+	Metadata      map[string]interface{} `json:"metadata,omitempty"`
+	RetentionDays *int                   `json:"retentionDays,omitempty"`
+	CreatedAt     *time.Time             `json:"createdAt,omitempty"`
+	UpdatedAt     *time.Time             `json:"updatedAt,omitempty"`
+}
+
+// ProjectRequest represents the request body for creating or updating a project.
+// Retention is the number of days data is kept; nil keeps the server default.
+type ProjectRequest struct {
+	Name      string                 `json:"name"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+	Retention *int                   `json:"retention,omitempty"`
+}
+
+// Membership represents a user's role in an organization or project
+type Membership struct {
+	UserID string         `json:"userId"`
+	Role   MembershipRole `json:"role"`
+	Email  string         `json:"email,omitempty"`
+	Name   string         `json:"name,omitempty"`
+}
+
+// APIKey represents a project API key. SecretKey is only returned when the key is created.
+type APIKey struct {
+	ID               string     `json:"id"`
+	PublicKey        string     `json:"publicKey"`
+	SecretKey        string     `json:"secretKey,omitempty"`
+	DisplaySecretKey string     `json:"displaySecretKey"`
+	Note             string     `json:"note,omitempty"`
+	CreatedAt        *time.Time `json:"createdAt,omitempty"`
+	ExpiresAt        *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt       *time.Time `json:"lastUsedAt,omitempty"`
+}
+
+// organizationProjectList represents the response of the organization projects endpoint
+type organizationProjectList struct {
+	Projects []Project `json:"projects"`
+}
+
+// membershipList represents the response of the membership endpoints
+type membershipList struct {
+	Memberships []Membership `json:"memberships"`
+}
+
+// membershipRequest represents the request body for upserting or deleting a membership
+type membershipRequest struct {
+	UserID string         `json:"userId"`
+	Role   MembershipRole `json:"role,omitempty"`
+}
+
+// apiKeyList represents the response of the project API keys endpoint
+type apiKeyList struct {
+	APIKeys []APIKey `json:"apiKeys"`
+}
+
+// createAPIKeyRequest represents the request body for creating a project API key
+type createAPIKeyRequest struct {
+	Note string `json:"note,omitempty"`
+}
+
+// ListProjects retrieves all projects of the organization
+// https://api.reference.langfuse.com/#tag/organizations/get/api/public/organizations/projects
+func (s *OrganizationsService) ListProjects(ctx context.Context) ([]Project, error) {
+	body, err := s.client.DoWithContext(ctx, "GET", "/api/public/organizations/projects", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing projects: %w", err)
+	}
+
+	var projects organizationProjectList
+	err = json.Unmarshal(body, &projects)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling projects data: %w", err)
+	}
+
+	return projects.Projects, nil
+}
+
+// CreateProject creates a project in the organization
+// https://api.reference.langfuse.com/#tag/projects/post/api/public/projects
+func (s *OrganizationsService) CreateProject(ctx context.Context, project ProjectRequest) (*Project, error) {
+	if project.Name == "" {
+		return nil, errors.New("error creating project: name is required")
+	}
+
+	body, err := s.client.DoWithContext(ctx, "POST", "/api/public/projects", &project)
+	if err != nil {
+		return nil, fmt.Errorf("error creating project: %w", err)
+	}
+
+	var created Project
+	err = json.Unmarshal(body, &created)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling created project data: %w", err)
+	}
+
+	return &created, nil
+}
+
+// UpdateProject updates the name, metadata and retention of a project
+// https://api.reference.langfuse.com/#tag/projects/put/api/public/projects/{projectId}
+func (s *OrganizationsService) UpdateProject(
+	ctx context.Context,
+	projectID string,
+	project ProjectRequest,
+) (*Project, error) {
+	if project.Name == "" {
+		return nil, errors.New("error updating project: name is required")
+	}
+
+	u := fmt.Sprintf("/api/public/projects/%s", url.PathEscape(projectID))
+
+	body, err := s.client.DoWithContext(ctx, "PUT", u, &project)
+	if err != nil {
+		return nil, fmt.Errorf("error updating project: %w", err)
+	}
+
+	var updated Project
+	err = json.Unmarshal(body, &updated)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling updated project data: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteProject deletes a project. Deletion happens asynchronously on the server.
+// https://api.reference.langfuse.com/#tag/projects/delete/api/public/projects/{projectId}
+func (s *OrganizationsService) DeleteProject(ctx context.Context, projectID string) error {
+	u := fmt.Sprintf("/api/public/projects/%s", url.PathEscape(projectID))
+
+	_, err := s.client.DoWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting project: %w", err)
+	}
+
+	return nil
+}
+
+// ListMemberships retrieves the memberships of the organization
+// https://api.reference.langfuse.com/#tag/organizations/get/api/public/organizations/memberships
+func (s *OrganizationsService) ListMemberships(ctx context.Context) ([]Membership, error) {
+	return s.listMemberships(ctx, "/api/public/organizations/memberships")
+}
+
+// UpsertMembership sets the role of a user in the organization
+// https://api.reference.langfuse.com/#tag/organizations/put/api/public/organizations/memberships
+func (s *OrganizationsService) UpsertMembership(
+	ctx context.Context,
+	userID string,
+	role MembershipRole,
+) (*Membership, error) {
+	return s.upsertMembership(ctx, "/api/public/organizations/memberships", userID, role)
+}
+
+// DeleteMembership removes a user from the organization
+// https://api.reference.langfuse.com/#tag/organizations/delete/api/public/organizations/memberships
+func (s *OrganizationsService) DeleteMembership(ctx context.Context, userID string) error {
+	return s.deleteMembership(ctx, "/api/public/organizations/memberships", userID)
+}
+
+// ListProjectMemberships retrieves the memberships of a project
+// https://api.reference.langfuse.com/#tag/organizations/get/api/public/projects/{projectId}/memberships
+func (s *OrganizationsService) ListProjectMemberships(ctx context.Context, projectID string) ([]Membership, error) {
+	return s.listMemberships(ctx, projectMembershipsURL(projectID))
+}
+
+// UpsertProjectMembership sets the role of a user in a project. The user must be
+// a member of the organization.
+// https://api.reference.langfuse.com/#tag/organizations/put/api/public/projects/{projectId}/memberships
+func (s *OrganizationsService) UpsertProjectMembership(
+	ctx context.Context,
+	projectID, userID string,
+	role MembershipRole,
+) (*Membership, error) {
+	return s.upsertMembership(ctx, projectMembershipsURL(projectID), userID, role)
+}
+
+// DeleteProjectMembership removes a user's project-level role
+// https://api.reference.langfuse.com/#tag/organizations/delete/api/public/projects/{projectId}/memberships
+func (s *OrganizationsService) DeleteProjectMembership(ctx context.Context, projectID, userID string) error {
+	return s.deleteMembership(ctx, projectMembershipsURL(projectID), userID)
+}
+
+// ListAPIKeys retrieves the API keys of a project. Secret keys are only shown in abbreviated form.
+// https://api.reference.langfuse.com/#tag/projects/get/api/public/projects/{projectId}/apiKeys
+func (s *OrganizationsService) ListAPIKeys(ctx context.Context, projectID string) ([]APIKey, error) {
+	u := fmt.Sprintf("/api/public/projects/%s/apiKeys", url.PathEscape(projectID))
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing API keys: %w", err)
+	}
+
+	var keys apiKeyList
+	err = json.Unmarshal(body, &keys)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling API keys data: %w", err)
+	}
+
+	return keys.APIKeys, nil
+}
+
+// CreateAPIKey creates an API key for a project. The returned key holds the only
+// copy of the secret key.
+// https://api.reference.langfuse.com/#tag/projects/post/api/public/projects/{projectId}/apiKeys
+func (s *OrganizationsService) CreateAPIKey(ctx context.Context, projectID, note string) (*APIKey, error) {
+	u := fmt.Sprintf("/api/public/projects/%s/apiKeys", url.PathEscape(projectID))
+
+	body, err := s.client.DoWithContext(ctx, "POST", u, &createAPIKeyRequest{Note: note})
+	if err != nil {
+		return nil, fmt.Errorf("error creating API key: %w", err)
+	}
+
+	var key APIKey
+	err = json.Unmarshal(body, &key)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling API key data: %w", err)
+	}
+
+	return &key, nil
+}
+
+// DeleteAPIKey deletes an API key of a project
+// https://api.reference.langfuse.com/#tag/projects/delete/api/public/projects/{projectId}/apiKeys/{apiKeyId}
+func (s *OrganizationsService) DeleteAPIKey(ctx context.Context, projectID, apiKeyID string) error {
+	u := fmt.Sprintf("/api/public/projects/%s/apiKeys/%s", url.PathEscape(projectID), url.PathEscape(apiKeyID))
+
+	_, err := s.client.DoWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting API key: %w", err)
+	}
+
+	return nil
+}
+
+// listMemberships retrieves the memberships at the given organization or project endpoint
+func (s *OrganizationsService) listMemberships(ctx context.Context, u string) ([]Membership, error) {
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing memberships: %w", err)
+	}
+
+	var memberships membershipList
+	err = json.Unmarshal(body, &memberships)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling memberships data: %w", err)
+	}
+
+	return memberships.Memberships, nil
+}
+
+// upsertMembership sets a user's role at the given organization or project endpoint
+func (s *OrganizationsService) upsertMembership(
+	ctx context.Context,
+	u, userID string,
+	role MembershipRole,
+) (*Membership, error) {
+	if userID == "" || role == "" {
+		return nil, errors.New("error updating membership: user ID and role are required")
+	}
+
+	body, err := s.client.DoWithContext(ctx, "PUT", u, &membershipRequest{UserID: userID, Role: role})
+	if err != nil {
+		return nil, fmt.Errorf("error updating membership: %w", err)
+	}
+
+	var membership Membership
+	err = json.Unmarshal(body, &membership)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling membership data: %w", err)
+	}
+
+	return &membership, nil
+}
+
+// deleteMembership removes a user at the given organization or project endpoint
+func (s *OrganizationsService) deleteMembership(ctx context.Context, u, userID string) error {
+	if userID == "" {
+		return errors.New("error deleting membership: user ID is required")
+	}
+
+	_, err := s.client.DoWithContext(ctx, "DELETE", u, &membershipRequest{UserID: userID})
+	if err != nil {
+		return fmt.Errorf("error deleting membership: %w", err)
+	}
+
+	return nil
+}
+
+func projectMembershipsURL(projectID string) string {
+	return fmt.Sprintf("/api/public/projects/%s/memberships", url.PathEscape(projectID))
+}
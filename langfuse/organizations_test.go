@@ -0,0 +1,267 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupOrganizationsTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.Organizations = (*OrganizationsService)(&service{client: client})
+
+	return client, server
+}
+
+func TestOrganizationsService_Projects(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/public/organizations/projects":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"projects": [{"id": "project-1", "name": "Search", "metadata": {"team": "search"}}]}`))
+		case r.Method == "POST" && r.URL.Path == "/api/public/projects":
+			var payload map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			if payload["name"] != "Checkout" || payload["retention"] != 30.0 {
+				t.Errorf("Unexpected create payload %v", payload)
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id": "project-2", "name": "Checkout", "retentionDays": 30}`))
+		case r.Method == "PUT" && r.URL.Path == "/api/public/projects/project-2":
+			var payload map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			if _, ok := payload["retention"]; ok {
+				t.Errorf("Expected retention to be omitted, got %v", payload)
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id": "project-2", "name": "Payments"}`))
+		case r.Method == "DELETE" && r.URL.Path == "/api/public/projects/project-2":
+			w.WriteHeader(http.StatusAccepted)
+			w.Write([]byte(`{"success": true, "message": "Project deletion has been initiated"}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+
+	client, server := setupOrganizationsTestClient(handler)
+	defer server.Close()
+
+	ctx := context.Background()
+
+	projects, err := client.Organizations.ListProjects(ctx)
+	if err != nil || len(projects) != 1 || projects[0].Metadata["team"] != "search" {
+		t.Fatalf("Unexpected list result %+v, %v", projects, err)
+	}
+
+	retention := 30
+	created, err := client.Organizations.CreateProject(ctx, ProjectRequest{Name: "Checkout", Retention: &retention})
+	if err != nil || created.ID != "project-2" || created.RetentionDays == nil || *created.RetentionDays != 30 {
+		t.Fatalf("Unexpected create result %+v, %v", created, err)
+	}
+
+	updated, err := client.Organizations.UpdateProject(ctx, "project-2", ProjectRequest{Name: "Payments"})
+	if err != nil || updated.Name != "Payments" {
+		t.Fatalf("Unexpected update result %+v, %v", updated, err)
+	}
+
+	if err := client.Organizations.DeleteProject(ctx, "project-2"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestOrganizationsService_CreateProject_RequiresName(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request for invalid project")
+	}
+
+	client, server := setupOrganizationsTestClient(handler)
+	defer server.Close()
+
+	_, err := client.Organizations.CreateProject(context.Background(), ProjectRequest{})
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error creating project: name is required"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
+
+func TestOrganizationsService_Memberships(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		list   func(c *Client) ([]Membership, error)
+		upsert func(c *Client) (*Membership, error)
+		delete func(c *Client) error
+	}{
+		{
+			name: "organization",
+			path: "/api/public/organizations/memberships",
+			list: func(c *Client) ([]Membership, error) {
+				return c.Organizations.ListMemberships(context.Background())
+			},
+			upsert: func(c *Client) (*Membership, error) {
+				return c.Organizations.UpsertMembership(context.Background(), "user-1", MembershipRoleAdmin)
+			},
+			delete: func(c *Client) error {
+				return c.Organizations.DeleteMembership(context.Background(), "user-1")
+			},
+		},
+		{
+			name: "project",
+			path: "/api/public/projects/project-1/memberships",
+			list: func(c *Client) ([]Membership, error) {
+				return c.Organizations.ListProjectMemberships(context.Background(), "project-1")
+			},
+			upsert: func(c *Client) (*Membership, error) {
+				return c.Organizations.UpsertProjectMembership(
+					context.Background(), "project-1", "user-1", MembershipRoleAdmin)
+			},
+			delete: func(c *Client) error {
+				return c.Organizations.DeleteProjectMembership(context.Background(), "project-1", "user-1")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != tt.path {
+					t.Errorf("Expected path %s, got %s", tt.path, r.URL.Path)
+				}
+
+				var payload map[string]interface{}
+				if r.Method != "GET" {
+					if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+						t.Fatalf("Failed to decode request body: %v", err)
+					}
+				}
+
+				w.WriteHeader(http.StatusOK)
+				switch r.Method {
+				case "GET":
+					w.Write([]byte(`{"memberships": [{"userId": "user-1", "role": "MEMBER"}]}`))
+				case "PUT":
+					if payload["userId"] != "user-1" || payload["role"] != "ADMIN" {
+						t.Errorf("Unexpected upsert payload %v", payload)
+					}
+					w.Write([]byte(`{"userId": "user-1", "role": "ADMIN", "email": "a@example.com"}`))
+				case "DELETE":
+					if payload["userId"] != "user-1" {
+						t.Errorf("Unexpected delete payload %v", payload)
+					}
+					w.Write([]byte(`{"message": "Membership deleted", "userId": "user-1"}`))
+				}
+			}
+
+			client, server := setupOrganizationsTestClient(handler)
+			defer server.Close()
+
+			memberships, err := tt.list(client)
+			if err != nil || len(memberships) != 1 || memberships[0].Role != MembershipRoleMember {
+				t.Fatalf("Unexpected list result %+v, %v", memberships, err)
+			}
+
+			membership, err := tt.upsert(client)
+			if err != nil || membership.Role != MembershipRoleAdmin {
+				t.Fatalf("Unexpected upsert result %+v, %v", membership, err)
+			}
+
+			if err := tt.delete(client); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestOrganizationsService_APIKeys(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/public/projects/project-1/apiKeys":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"apiKeys": [{"id": "key-1", "publicKey": "pk-lf-1", "displaySecretKey": "sk-lf-...abcd",
+				"note": "ci", "createdAt": "2024-01-01T00:00:00Z"}]}`))
+		case r.Method == "POST" && r.URL.Path == "/api/public/projects/project-1/apiKeys":
+			var payload map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			if payload["note"] != "deploy" {
+				t.Errorf("Expected note deploy, got %v", payload["note"])
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id": "key-2", "publicKey": "pk-lf-2", "secretKey": "sk-lf-secret",
+				"displaySecretKey": "sk-lf-...cret", "note": "deploy"}`))
+		case r.Method == "DELETE" && r.URL.Path == "/api/public/projects/project-1/apiKeys/key-2":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"success": true}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+
+	client, server := setupOrganizationsTestClient(handler)
+	defer server.Close()
+
+	ctx := context.Background()
+
+	keys, err := client.Organizations.ListAPIKeys(ctx, "project-1")
+	if err != nil || len(keys) != 1 || keys[0].SecretKey != "" || keys[0].CreatedAt == nil {
+		t.Fatalf("Unexpected list result %+v, %v", keys, err)
+	}
+
+	key, err := client.Organizations.CreateAPIKey(ctx, "project-1", "deploy")
+	if err != nil || key.SecretKey != "sk-lf-secret" || key.PublicKey != "pk-lf-2" {
+		t.Fatalf("Unexpected create result %+v, %v", key, err)
+	}
+
+	if err := client.Organizations.DeleteAPIKey(ctx, "project-1", "key-2"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestOrganizationsService_DeleteAPIKey_Error(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("organization key required"))
+	}
+
+	client, server := setupOrganizationsTestClient(handler)
+	defer server.Close()
+
+	err := client.Organizations.DeleteAPIKey(context.Background(), "project-1", "key-1")
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error deleting API key: client error 403: organization key required"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
@@ -0,0 +1,52 @@
+package langfuse
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+)
+
+const (
+	otelTracesPath        = "/api/public/otel/v1/traces"
+	otelProtobufMediaType = "application/x-protobuf"
+)
+
+// OTelService handles exporting OpenTelemetry data to the Langfuse OTLP endpoint
+type OTelService service
+
+// ExportTraces posts a protobuf-encoded OTLP ExportTraceServiceRequest to Langfuse.
+// The payload is sent as-is using the client's credentials and retry configuration,
+// so it can be produced by any OTLP protobuf encoder (e.g. proto.Marshal on a
+// go.opentelemetry.io/proto/otlp/collector/trace/v1.ExportTraceServiceRequest).
+// When the client is disabled, ExportTraces is a no-op.
+// https://langfuse.com/docs/opentelemetry/get-started
+func (s *OTelService) ExportTraces(ctx context.Context, payload []byte) error {
+	if s.client.disabled {
+		return nil
+	}
+
+	if len(payload) == 0 {
+		return fmt.Errorf("error exporting traces: payload is empty")
+	}
+
+	_, err := s.client.doRequest(ctx, "POST", otelTracesPath, bytes.NewReader(payload), otelProtobufMediaType)
+	if err != nil {
+		return fmt.Errorf("error exporting traces: %w", err)
+	}
+
+	return nil
+}
+
+// TracesEndpoint returns the full URL of the Langfuse OTLP/HTTP traces endpoint,
+// suitable for configuring an external OTLP exporter.
+func (s *OTelService) TracesEndpoint() string {
+	return s.client.baseUrl + otelTracesPath
+}
+
+// Headers returns the HTTP headers required by the Langfuse OTLP endpoint,
+// suitable for configuring an external OTLP exporter with the client's credentials.
+func (s *OTelService) Headers() map[string]string {
+	return map[string]string{
+		"Authorization": fmt.Sprintf("Basic %s", s.client.base64Token),
+	}
+}
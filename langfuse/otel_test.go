@@ -0,0 +1,173 @@
+package langfuse
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupOTelTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.OTel = (*OTelService)(&service{client: client})
+
+	return client, server
+}
+
+func TestOTelService_ExportTraces_Success(t *testing.T) {
+	payload := []byte{0x0a, 0x03, 0x01, 0x02, 0x03}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/api/public/otel/v1/traces" {
+			t.Errorf("Expected path /api/public/otel/v1/traces, got %s", r.URL.Path)
+		}
+
+		if r.Header.Get("Content-Type") != "application/x-protobuf" {
+			t.Errorf("Expected Content-Type application/x-protobuf, got %s", r.Header.Get("Content-Type"))
+		}
+
+		if r.Header.Get("Authorization") != "Basic test-token" {
+			t.Errorf("Expected Authorization 'Basic test-token', got %s", r.Header.Get("Authorization"))
+		}
+
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("Failed to read request body: %v", err)
+		}
+
+		if !bytes.Equal(body, payload) {
+			t.Errorf("Expected body %v, got %v", payload, body)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}
+
+	client, server := setupOTelTestClient(handler)
+	defer server.Close()
+
+	if err := client.OTel.ExportTraces(context.Background(), payload); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestOTelService_ExportTraces_RetriesServerErrors(t *testing.T) {
+	attempts := 0
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		body, _ := io.ReadAll(r.Body)
+		if len(body) != 3 {
+			t.Errorf("Expected payload to be resent on retry, got %d bytes", len(body))
+		}
+
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+
+	client, server := setupOTelTestClient(handler)
+	defer server.Close()
+
+	if err := client.OTel.ExportTraces(context.Background(), []byte{1, 2, 3}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestOTelService_ExportTraces_ClientError(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte("unauthorized"))
+	}
+
+	client, server := setupOTelTestClient(handler)
+	defer server.Close()
+
+	err := client.OTel.ExportTraces(context.Background(), []byte{1})
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error exporting traces: client error 401: unauthorized"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
+
+func TestOTelService_ExportTraces_EmptyPayload(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request for empty payload")
+	}
+
+	client, server := setupOTelTestClient(handler)
+	defer server.Close()
+
+	if err := client.OTel.ExportTraces(context.Background(), nil); err == nil {
+		t.Fatal("Expected error for empty payload, got nil")
+	}
+}
+
+func TestOTelService_ExportTraces_CancelledContext(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	client, server := setupOTelTestClient(handler)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := client.OTel.ExportTraces(ctx, []byte{1})
+	if err == nil {
+		t.Fatal("Expected error for cancelled context, got nil")
+	}
+
+	if !strings.Contains(err.Error(), "context canceled") {
+		t.Errorf("Expected context canceled error, got %v", err)
+	}
+}
+
+func TestOTelService_EndpointAndHeaders(t *testing.T) {
+	client := NewClient(&Config{
+		ServerUrl:   "https://cloud.langfuse.com",
+		Base64Token: "abc123",
+	})
+
+	if got := client.OTel.TracesEndpoint(); got != "https://cloud.langfuse.com/api/public/otel/v1/traces" {
+		t.Errorf("Unexpected traces endpoint: %s", got)
+	}
+
+	headers := client.OTel.Headers()
+	if headers["Authorization"] != "Basic abc123" {
+		t.Errorf("Expected Authorization 'Basic abc123', got %s", headers["Authorization"])
+	}
+}
@@ -0,0 +1,87 @@
+package langfuse
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// MetaResponse holds the pagination information of a list response
+type MetaResponse struct {
+	Page       int `json:"page"`
+	Limit      int `json:"limit"`
+	TotalItems int `json:"totalItems"`
+	TotalPages int `json:"totalPages"`
+}
+
+// paginate returns an iterator over all items of a paginated list endpoint,
+// starting at the given page and fetching further pages lazily. Iteration stops
+// at the first error, which is yielded together with the zero value of T.
+func paginate[T any](
+	ctx context.Context,
+	startPage int,
+	fetch func(ctx context.Context, page int) ([]T, *MetaResponse, error),
+) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		if startPage < 1 {
+			startPage = 1
+		}
+
+		for page := startPage; ; page++ {
+			items, meta, err := fetch(ctx, page)
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if len(items) == 0 || meta == nil || page >= meta.TotalPages {
+				return
+			}
+		}
+	}
+}
+
+// withQuery appends the encoded query parameters to the URL path, if any
+func withQuery(u string, params url.Values) string {
+	if len(params) == 0 {
+		return u
+	}
+	return u + "?" + params.Encode()
+}
+
+// setString sets the query parameter if value is not empty
+func setString(params url.Values, key, value string) {
+	if value != "" {
+		params.Set(key, value)
+	}
+}
+
+// setInt sets the query parameter if value is positive
+func setInt(params url.Values, key string, value int) {
+	if value > 0 {
+		params.Set(key, strconv.Itoa(value))
+	}
+}
+
+// setTime sets the query parameter to the ISO 8601 representation of t if t is not zero
+func setTime(params url.Values, key string, t time.Time) {
+	if !t.IsZero() {
+		params.Set(key, t.UTC().Format(time.RFC3339Nano))
+	}
+}
+
+// addStrings adds one query parameter per value
+func addStrings(params url.Values, key string, values []string) {
+	for _, value := range values {
+		params.Add(key, value)
+	}
+}
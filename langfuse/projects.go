@@ -1,13 +1,24 @@
 package langfuse
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
 )
 
 // ProjectsService handles operations related to projects
 type ProjectsService service
 
+// projectList represents the response of the projects endpoint
+type projectList struct {
+	Data []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"data"`
+}
+
 // GetProject retrieves a project associated with the given API token
 // https://api.reference.langfuse.com/#tag/projects/get/api/public/projects
 func (s *ProjectsService) GetProject() (map[string]interface{}, error) {
@@ -26,3 +37,103 @@ func (s *ProjectsService) GetProject() (map[string]interface{}, error) {
 
 	return appData, nil
 }
+
+// projectIDCall is an in-flight fetch of the project ID shared by concurrent
+// ProjectID callers. done is closed once id and err are set.
+type projectIDCall struct {
+	done chan struct{}
+	id   string
+	err  error
+}
+
+// ProjectID returns the ID of the project associated with the API token. The ID
+// is fetched once and cached on the client. Concurrent callers share a single
+// fetch, which is not cancelled with any one caller's ctx; each caller stops
+// waiting for it when its own ctx is done.
+// https://api.reference.langfuse.com/#tag/projects/get/api/public/projects
+func (s *ProjectsService) ProjectID(ctx context.Context) (string, error) {
+	s.client.projectIDMu.Lock()
+	if s.client.projectID != "" {
+		id := s.client.projectID
+		s.client.projectIDMu.Unlock()
+		return id, nil
+	}
+
+	call := s.client.projectIDCall
+	if call == nil {
+		call = &projectIDCall{done: make(chan struct{})}
+		s.client.projectIDCall = call
+		go s.runProjectIDCall(context.WithoutCancel(ctx), call)
+	}
+	s.client.projectIDMu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.id, call.err
+	case <-ctx.Done():
+		return "", fmt.Errorf("error fetching project: %w", ctx.Err())
+	}
+}
+
+// runProjectIDCall performs the shared fetch, caching the ID on success
+func (s *ProjectsService) runProjectIDCall(ctx context.Context, call *projectIDCall) {
+	call.id, call.err = s.fetchProjectID(ctx)
+
+	s.client.projectIDMu.Lock()
+	if call.err == nil {
+		s.client.projectID = call.id
+	}
+	s.client.projectIDCall = nil
+	s.client.projectIDMu.Unlock()
+	close(call.done)
+}
+
+func (s *ProjectsService) fetchProjectID(ctx context.Context) (string, error) {
+	body, err := s.client.DoWithContext(ctx, "GET", "/api/public/projects", nil)
+	if err != nil {
+		return "", fmt.Errorf("error fetching project: %w", err)
+	}
+
+	var projects projectList
+	err = json.Unmarshal(body, &projects)
+	if err != nil {
+		return "", fmt.Errorf("error unmarshalling project data: %w", err)
+	}
+
+	if len(projects.Data) == 0 || projects.Data[0].ID == "" {
+		return "", errors.New("error fetching project: no project found for API key")
+	}
+
+	return projects.Data[0].ID, nil
+}
+
+// SetRetention sets the number of days the data of a project is kept, where 0
+// keeps data indefinitely. It requires an organization-scoped API key, as the
+// project's name and metadata are looked up to update it.
+// https://api.reference.langfuse.com/#tag/projects/put/api/public/projects/{projectId}
+func (s *ProjectsService) SetRetention(ctx context.Context, projectID string, days int) (*Project, error) {
+	if days != 0 && days < 3 {
+		return nil, errors.New("error setting retention: days must be 0 or at least 3")
+	}
+
+	projects, err := s.client.Organizations.ListProjects(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error setting retention: %w", err)
+	}
+
+	index := slices.IndexFunc(projects, func(p Project) bool { return p.ID == projectID })
+	if index < 0 {
+		return nil, fmt.Errorf("error setting retention: project %s not found", projectID)
+	}
+
+	project, err := s.client.Organizations.UpdateProject(ctx, projectID, ProjectRequest{
+		Name:      projects[index].Name,
+		Metadata:  projects[index].Metadata,
+		Retention: &days,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error setting retention: %w", err)
+	}
+
+	return project, nil
+}
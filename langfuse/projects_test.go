@@ -1,9 +1,13 @@
 package langfuse
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -26,6 +30,7 @@ func setupProjectsTestClient(handler http.HandlerFunc) (*Client, *httptest.Serve
 	}
 
 	client.Projects = (*ProjectsService)(&service{client: client})
+	client.Organizations = (*OrganizationsService)(&service{client: client})
 
 	return client, server
 }
@@ -270,3 +275,186 @@ func TestProjectsService_GetProject_ComplexStructure(t *testing.T) {
 		t.Errorf("Expected 2 tags, got %d", len(tags))
 	}
 }
+
+func TestProjectsService_ProjectID_Cached(t *testing.T) {
+	requests := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.URL.Path != "/api/public/projects" {
+			t.Errorf("Expected path /api/public/projects, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [{"id": "project-1", "name": "Test Project"}]}`))
+	}
+
+	client, server := setupProjectsTestClient(handler)
+	defer server.Close()
+
+	for range 2 {
+		id, err := client.Projects.ProjectID(context.Background())
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if id != "project-1" {
+			t.Errorf("Expected project-1, got %s", id)
+		}
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected project ID to be fetched once, got %d requests", requests)
+	}
+}
+
+func TestProjectsService_ProjectID_Concurrent(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [{"id": "project-1", "name": "Test Project"}]}`))
+	}
+
+	client, server := setupProjectsTestClient(handler)
+	defer server.Close()
+
+	// The caller starting the fetch gives up once its context is done, without
+	// failing the fetch for the callers still waiting on it
+	leaderCtx, cancelLeader := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancelLeader()
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := client.Projects.ProjectID(leaderCtx)
+		leaderErr <- err
+	}()
+	for requests.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	var wg sync.WaitGroup
+	ids := make([]string, 5)
+	for i := range ids {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id, err := client.Projects.ProjectID(context.Background())
+			if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			ids[i] = id
+		}()
+	}
+
+	if err := <-leaderErr; !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+
+	// A waiting caller gives up once its context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := client.Projects.ProjectID(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+
+	close(release)
+	wg.Wait()
+
+	for _, id := range ids {
+		if id != "project-1" {
+			t.Errorf("Expected project-1, got %s", id)
+		}
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("Expected project ID to be fetched once, got %d requests", n)
+	}
+}
+
+func TestProjectsService_ProjectID_NoProject(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": []}`))
+	}
+
+	client, server := setupProjectsTestClient(handler)
+	defer server.Close()
+
+	_, err := client.Projects.ProjectID(context.Background())
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error fetching project: no project found for API key"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
+
+func TestProjectsService_SetRetention(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/public/organizations/projects":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"projects": [{"id": "project-1", "name": "Search", "metadata": {"team": "search"}}]}`))
+		case r.Method == "PUT" && r.URL.Path == "/api/public/projects/project-1":
+			var payload map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			metadata, _ := payload["metadata"].(map[string]interface{})
+			if payload["name"] != "Search" || payload["retention"] != 30.0 || metadata["team"] != "search" {
+				t.Errorf("Unexpected update payload %v", payload)
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id": "project-1", "name": "Search", "retentionDays": 30}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+
+	client, server := setupProjectsTestClient(handler)
+	defer server.Close()
+
+	project, err := client.Projects.SetRetention(context.Background(), "project-1", 30)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if project.RetentionDays == nil || *project.RetentionDays != 30 {
+		t.Errorf("Expected retention of 30 days, got %+v", project)
+	}
+}
+
+func TestProjectsService_SetRetention_Errors(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"projects": [{"id": "project-1", "name": "Search"}]}`))
+	}
+
+	client, server := setupProjectsTestClient(handler)
+	defer server.Close()
+
+	tests := []struct {
+		name          string
+		projectID     string
+		days          int
+		expectedError string
+	}{
+		{"too short", "project-1", 2, "error setting retention: days must be 0 or at least 3"},
+		{"unknown project", "project-2", 30, "error setting retention: project project-2 not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.Projects.SetRetention(context.Background(), tt.projectID, tt.days)
+			if err == nil || err.Error() != tt.expectedError {
+				t.Errorf("Expected error '%s', got '%v'", tt.expectedError, err)
+			}
+		})
+	}
+}
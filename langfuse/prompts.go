@@ -9,7 +9,8 @@ import (
 // PromptsService handles operations related to prompts
 type PromptsService service
 
-// Prompt represents a prompt in langfuse
+// Prompt represents a prompt in langfuse. IsFallback is set on prompts returned
+// from a fallback instead of Langfuse, see WithFallbackPrompt.
 type Prompt struct {
 	Config        map[string]interface{} `json:"config,omitempty"`
 	CommitMessage string                 `json:"commitMessage,omitempty"`
@@ -19,6 +20,7 @@ type Prompt struct {
 	Version       int                    `json:"version,omitempty"`
 	Tags          []string               `json:"tags,omitempty"`
 	Type          string                 `json:"type"`
+	IsFallback    bool                   `json:"-"`
 }
 
 // ChatMessage represents a chat message in a chat prompt
@@ -28,11 +30,27 @@ type ChatMessage struct {
 	Content string `json:"content"`
 }
 
+// PromptOption configures optional behaviour of PromptsService.GetPromptByName
+type PromptOption func(*promptOptions)
+
+type promptOptions struct {
+	fallback *Prompt
+}
+
 // UpdatePromptVersionLabelsRequest represents the request body for updating prompt version labels
 type UpdatePromptVersionLabelsRequest struct {
 	NewLabels []string `json:"newLabels"`
 }
 
+// WithFallbackPrompt sets the prompt GetPromptByName returns when the client is
+// disabled, so that callers need no separate code path for disabled clients. A
+// copy of fallback is returned with IsFallback set.
+func WithFallbackPrompt(fallback *Prompt) PromptOption {
+	return func(o *promptOptions) {
+		o.fallback = fallback
+	}
+}
+
 // Get a list of prompt names with versions and labels for the given API token
 // https://api.reference.langfuse.com/#tag/prompts/get/api/public/v2/prompts
 func (s *PromptsService) GetPrompts() (map[string]interface{}, error) {
@@ -52,9 +70,21 @@ func (s *PromptsService) GetPrompts() (map[string]interface{}, error) {
 	return promptsData, nil
 }
 
-// GetPromptByName retrieves a specific prompt by its Name
+// GetPromptByName retrieves a specific prompt by its Name. When the client is
+// disabled, the fallback set with WithFallbackPrompt is returned, if any.
 // https://api.reference.langfuse.com/#tag/prompts/get/api/public/v2/prompts/{promptName}
-func (s *PromptsService) GetPromptByName(name, label string, version *int) (*Prompt, error) {
+func (s *PromptsService) GetPromptByName(name, label string, version *int, opts ...PromptOption) (*Prompt, error) {
+	var options promptOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if s.client.disabled && options.fallback != nil {
+		fallback := *options.fallback
+		fallback.IsFallback = true
+		return &fallback, nil
+	}
+
 	// Build URL path with properly escaped name
 	u := fmt.Sprintf("/api/public/v2/prompts/%s", url.PathEscape(name))
 
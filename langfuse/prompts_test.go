@@ -235,6 +235,26 @@ func TestPromptsService_GetPromptByName_Success(t *testing.T) {
 	}
 }
 
+func TestPromptsService_GetPromptByName_FallbackIgnoredWhenEnabled(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name": "test-prompt", "type": "text", "prompt": "from langfuse", "version": 2}`))
+	}
+
+	client, server := setupPromptsTestClient(handler)
+	defer server.Close()
+
+	fallback := &Prompt{Name: "test-prompt", Type: "text", Prompt: "fallback"}
+	prompt, err := client.Prompts.GetPromptByName("test-prompt", "", nil, WithFallbackPrompt(fallback))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if prompt.Prompt != "from langfuse" || prompt.IsFallback {
+		t.Errorf("Expected the fetched prompt, got %+v", prompt)
+	}
+}
+
 func TestPromptsService_GetPromptByName_WithLabelAndVersion(t *testing.T) {
 	version := 2
 
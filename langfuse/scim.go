@@ -0,0 +1,279 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// SCIMService handles user provisioning through the SCIM 2.0 endpoints. It
+// requires a client configured with an organization-scoped API key.
+type SCIMService service
+
+// SCIMUserSchema is the schema URN of SCIM user resources
+const SCIMUserSchema = "urn:ietf:params:scim:schemas:core:2.0:User"
+
+// SCIMMeta holds the resource metadata of a SCIM resource
+type SCIMMeta struct {
+	ResourceType string     `json:"resourceType,omitempty"`
+	Location     string     `json:"location,omitempty"`
+	Created      *time.Time `json:"created,omitempty"`
+	LastModified *time.Time `json:"lastModified,omitempty"`
+}
+
+// SCIMName holds the name of a SCIM user
+type SCIMName struct {
+	Formatted string `json:"formatted,omitempty"`
+}
+
+// SCIMEmail represents an email address of a SCIM user
+type SCIMEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+// SCIMUser represents a user provisioned through SCIM. Password is only sent on creation.
+type SCIMUser struct {
+	Schemas  []string    `json:"schemas,omitempty"`
+	ID       string      `json:"id,omitempty"`
+	UserName string      `json:"userName"`
+	Name     SCIMName    `json:"name"`
+	Emails   []SCIMEmail `json:"emails,omitempty"`
+	Active   *bool       `json:"active,omitempty"`
+	Password string      `json:"password,omitempty"`
+	Meta     *SCIMMeta   `json:"meta,omitempty"`
+}
+
+// SCIMUserList represents a page of SCIM users. StartIndex is 1-based.
+type SCIMUserList struct {
+	Schemas      []string   `json:"schemas"`
+	TotalResults int        `json:"totalResults"`
+	StartIndex   int        `json:"startIndex"`
+	ItemsPerPage int        `json:"itemsPerPage"`
+	Resources    []SCIMUser `json:"Resources"`
+}
+
+// SCIMUserFilter holds the query parameters for listing SCIM users. Filter is a
+// SCIM filter expression such as `userName eq "jane@example.com"`, see SCIMFilterEq.
+// Zero values are omitted.
+type SCIMUserFilter struct {
+	Filter     string
+	StartIndex int
+	Count      int
+}
+
+// SCIMSupported describes whether an optional SCIM feature is supported
+type SCIMSupported struct {
+	Supported bool `json:"supported"`
+}
+
+// SCIMBulkSupport describes the bulk operation support of the service provider
+type SCIMBulkSupport struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+// SCIMFilterSupport describes the filter support of the service provider
+type SCIMFilterSupport struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+// SCIMAuthenticationScheme describes an authentication scheme accepted by the service provider
+type SCIMAuthenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	SpecURI     string `json:"specUri,omitempty"`
+	Primary     bool   `json:"primary,omitempty"`
+}
+
+// SCIMServiceProviderConfig describes the SCIM features supported by Langfuse
+type SCIMServiceProviderConfig struct {
+	Schemas               []string                   `json:"schemas"`
+	DocumentationURI      string                     `json:"documentationUri,omitempty"`
+	Patch                 SCIMSupported              `json:"patch"`
+	Bulk                  SCIMBulkSupport            `json:"bulk"`
+	Filter                SCIMFilterSupport          `json:"filter"`
+	ChangePassword        SCIMSupported              `json:"changePassword"`
+	Sort                  SCIMSupported              `json:"sort"`
+	ETag                  SCIMSupported              `json:"etag"`
+	AuthenticationSchemes []SCIMAuthenticationScheme `json:"authenticationSchemes"`
+	Meta                  *SCIMMeta                  `json:"meta,omitempty"`
+}
+
+// SCIMSchemaExtension references a schema extending a resource type
+type SCIMSchemaExtension struct {
+	Schema   string `json:"schema"`
+	Required bool   `json:"required"`
+}
+
+// SCIMResourceType describes a resource type exposed by the SCIM endpoints
+type SCIMResourceType struct {
+	Schemas          []string              `json:"schemas,omitempty"`
+	ID               string                `json:"id"`
+	Name             string                `json:"name"`
+	Endpoint         string                `json:"endpoint"`
+	Description      string                `json:"description,omitempty"`
+	Schema           string                `json:"schema"`
+	SchemaExtensions []SCIMSchemaExtension `json:"schemaExtensions,omitempty"`
+	Meta             *SCIMMeta             `json:"meta,omitempty"`
+}
+
+// SCIMSchema describes the attributes of a SCIM resource schema
+type SCIMSchema struct {
+	ID          string                   `json:"id"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description,omitempty"`
+	Attributes  []map[string]interface{} `json:"attributes"`
+	Meta        *SCIMMeta                `json:"meta,omitempty"`
+}
+
+// scimListResponse represents a SCIM list response
+type scimListResponse[T any] struct {
+	Resources []T `json:"Resources"`
+}
+
+// SCIMFilterEq returns a SCIM filter matching resources whose attribute equals value
+func SCIMFilterEq(attribute, value string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+	return fmt.Sprintf(`%s eq "%s"`, attribute, escaped)
+}
+
+// ServiceProviderConfig retrieves the SCIM features supported by Langfuse
+// https://api.reference.langfuse.com/#tag/scim/get/api/public/scim/ServiceProviderConfig
+func (s *SCIMService) ServiceProviderConfig(ctx context.Context) (*SCIMServiceProviderConfig, error) {
+	body, err := s.client.DoWithContext(ctx, "GET", "/api/public/scim/ServiceProviderConfig", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching SCIM service provider config: %w", err)
+	}
+
+	var config SCIMServiceProviderConfig
+	err = json.Unmarshal(body, &config)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling SCIM service provider config data: %w", err)
+	}
+
+	return &config, nil
+}
+
+// ResourceTypes retrieves the resource types exposed by the SCIM endpoints
+// https://api.reference.langfuse.com/#tag/scim/get/api/public/scim/ResourceTypes
+func (s *SCIMService) ResourceTypes(ctx context.Context) ([]SCIMResourceType, error) {
+	body, err := s.client.DoWithContext(ctx, "GET", "/api/public/scim/ResourceTypes", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching SCIM resource types: %w", err)
+	}
+
+	var resourceTypes scimListResponse[SCIMResourceType]
+	err = json.Unmarshal(body, &resourceTypes)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling SCIM resource types data: %w", err)
+	}
+
+	return resourceTypes.Resources, nil
+}
+
+// Schemas retrieves the schemas of the resources exposed by the SCIM endpoints
+// https://api.reference.langfuse.com/#tag/scim/get/api/public/scim/Schemas
+func (s *SCIMService) Schemas(ctx context.Context) ([]SCIMSchema, error) {
+	body, err := s.client.DoWithContext(ctx, "GET", "/api/public/scim/Schemas", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching SCIM schemas: %w", err)
+	}
+
+	var schemas scimListResponse[SCIMSchema]
+	err = json.Unmarshal(body, &schemas)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling SCIM schemas data: %w", err)
+	}
+
+	return schemas.Resources, nil
+}
+
+// ListUsers retrieves a page of the organization's users matching the filter
+// https://api.reference.langfuse.com/#tag/scim/get/api/public/scim/Users
+func (s *SCIMService) ListUsers(ctx context.Context, filter SCIMUserFilter) (*SCIMUserList, error) {
+	params := url.Values{}
+	setString(params, "filter", filter.Filter)
+	setInt(params, "startIndex", filter.StartIndex)
+	setInt(params, "count", filter.Count)
+	u := withQuery("/api/public/scim/Users", params)
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing SCIM users: %w", err)
+	}
+
+	var users SCIMUserList
+	err = json.Unmarshal(body, &users)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling SCIM users data: %w", err)
+	}
+
+	return &users, nil
+}
+
+// GetUser retrieves a user by its ID
+// https://api.reference.langfuse.com/#tag/scim/get/api/public/scim/Users/{userId}
+func (s *SCIMService) GetUser(ctx context.Context, userID string) (*SCIMUser, error) {
+	u := fmt.Sprintf("/api/public/scim/Users/%s", url.PathEscape(userID))
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching SCIM user: %w", err)
+	}
+
+	var user SCIMUser
+	err = json.Unmarshal(body, &user)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling SCIM user data: %w", err)
+	}
+
+	return &user, nil
+}
+
+// CreateUser creates a user and adds it to the organization, or adds an existing
+// user with the same user name. The user schema is set if user.Schemas is empty.
+// https://api.reference.langfuse.com/#tag/scim/post/api/public/scim/Users
+func (s *SCIMService) CreateUser(ctx context.Context, user SCIMUser) (*SCIMUser, error) {
+	if user.UserName == "" {
+		return nil, errors.New("error creating SCIM user: user name is required")
+	}
+
+	if len(user.Schemas) == 0 {
+		user.Schemas = []string{SCIMUserSchema}
+	}
+
+	body, err := s.client.DoWithContext(ctx, "POST", "/api/public/scim/Users", &user)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SCIM user: %w", err)
+	}
+
+	var created SCIMUser
+	err = json.Unmarshal(body, &created)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling created SCIM user data: %w", err)
+	}
+
+	return &created, nil
+}
+
+// DeleteUser removes a user from the organization. The user account itself is kept.
+// https://api.reference.langfuse.com/#tag/scim/delete/api/public/scim/Users/{userId}
+func (s *SCIMService) DeleteUser(ctx context.Context, userID string) error {
+	u := fmt.Sprintf("/api/public/scim/Users/%s", url.PathEscape(userID))
+
+	_, err := s.client.DoWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting SCIM user: %w", err)
+	}
+
+	return nil
+}
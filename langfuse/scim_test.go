@@ -0,0 +1,195 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupSCIMTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.SCIM = (*SCIMService)(&service{client: client})
+
+	return client, server
+}
+
+func TestSCIMService_Discovery(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		switch r.URL.Path {
+		case "/api/public/scim/ServiceProviderConfig":
+			w.Write([]byte(`{"schemas": ["urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"],
+				"patch": {"supported": false}, "bulk": {"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+				"filter": {"supported": true, "maxResults": 200}, "changePassword": {"supported": false},
+				"sort": {"supported": false}, "etag": {"supported": false},
+				"authenticationSchemes": [{"type": "httpbasic", "name": "HTTP Basic", "description": "API keys"}]}`))
+		case "/api/public/scim/ResourceTypes":
+			w.Write([]byte(`{"totalResults": 1, "Resources": [{"id": "User", "name": "User", "endpoint": "/Users",
+				"schema": "urn:ietf:params:scim:schemas:core:2.0:User"}]}`))
+		case "/api/public/scim/Schemas":
+			w.Write([]byte(`{"totalResults": 1, "Resources": [{"id": "urn:ietf:params:scim:schemas:core:2.0:User",
+				"name": "User", "attributes": [{"name": "userName", "type": "string"}]}]}`))
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+
+	client, server := setupSCIMTestClient(handler)
+	defer server.Close()
+
+	ctx := context.Background()
+
+	config, err := client.SCIM.ServiceProviderConfig(ctx)
+	if err != nil || !config.Filter.Supported || config.Filter.MaxResults != 200 || config.Patch.Supported {
+		t.Fatalf("Unexpected service provider config %+v, %v", config, err)
+	}
+
+	if len(config.AuthenticationSchemes) != 1 || config.AuthenticationSchemes[0].Type != "httpbasic" {
+		t.Errorf("Unexpected authentication schemes %+v", config.AuthenticationSchemes)
+	}
+
+	resourceTypes, err := client.SCIM.ResourceTypes(ctx)
+	if err != nil || len(resourceTypes) != 1 || resourceTypes[0].Endpoint != "/Users" {
+		t.Fatalf("Unexpected resource types %+v, %v", resourceTypes, err)
+	}
+
+	schemas, err := client.SCIM.Schemas(ctx)
+	if err != nil || len(schemas) != 1 || schemas[0].Attributes[0]["name"] != "userName" {
+		t.Fatalf("Unexpected schemas %+v, %v", schemas, err)
+	}
+}
+
+func TestSCIMService_ListUsers_Filter(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/scim/Users" {
+			t.Errorf("Expected path /api/public/scim/Users, got %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if query.Get("filter") != `userName eq "jane@example.com"` || query.Get("startIndex") != "1" ||
+			query.Get("count") != "20" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"schemas": ["urn:ietf:params:scim:api:messages:2.0:ListResponse"], "totalResults": 1,
+			"startIndex": 1, "itemsPerPage": 20, "Resources": [{"id": "user-1", "userName": "jane@example.com",
+			"name": {"formatted": "Jane Doe"}, "emails": [{"primary": true, "value": "jane@example.com"}],
+			"meta": {"resourceType": "User", "created": "2024-01-01T00:00:00Z"}}]}`))
+	}
+
+	client, server := setupSCIMTestClient(handler)
+	defer server.Close()
+
+	users, err := client.SCIM.ListUsers(context.Background(), SCIMUserFilter{
+		Filter:     SCIMFilterEq("userName", "jane@example.com"),
+		StartIndex: 1,
+		Count:      20,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if users.TotalResults != 1 || len(users.Resources) != 1 {
+		t.Fatalf("Unexpected users %+v", users)
+	}
+
+	user := users.Resources[0]
+	if user.Name.Formatted != "Jane Doe" || !user.Emails[0].Primary || user.Meta.Created == nil {
+		t.Errorf("Unexpected user %+v", user)
+	}
+}
+
+func TestSCIMService_Users(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/public/scim/Users":
+			var payload map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			schemas, _ := payload["schemas"].([]interface{})
+			if payload["userName"] != "jane@example.com" || len(schemas) != 1 || schemas[0] != SCIMUserSchema {
+				t.Errorf("Unexpected create payload %v", payload)
+			}
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id": "user-1", "userName": "jane@example.com", "name": {"formatted": "Jane Doe"}}`))
+		case r.Method == "GET" && r.URL.Path == "/api/public/scim/Users/user-1":
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"id": "user-1", "userName": "jane@example.com", "name": {"formatted": "Jane Doe"}}`))
+		case r.Method == "DELETE" && r.URL.Path == "/api/public/scim/Users/user-1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("Unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}
+
+	client, server := setupSCIMTestClient(handler)
+	defer server.Close()
+
+	ctx := context.Background()
+
+	created, err := client.SCIM.CreateUser(ctx, SCIMUser{
+		UserName: "jane@example.com",
+		Name:     SCIMName{Formatted: "Jane Doe"},
+		Emails:   []SCIMEmail{{Value: "jane@example.com", Primary: true}},
+	})
+	if err != nil || created.ID != "user-1" {
+		t.Fatalf("Unexpected create result %+v, %v", created, err)
+	}
+
+	user, err := client.SCIM.GetUser(ctx, "user-1")
+	if err != nil || user.UserName != "jane@example.com" {
+		t.Fatalf("Unexpected get result %+v, %v", user, err)
+	}
+
+	if err := client.SCIM.DeleteUser(ctx, "user-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestSCIMService_CreateUser_RequiresUserName(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request for invalid user")
+	}
+
+	client, server := setupSCIMTestClient(handler)
+	defer server.Close()
+
+	_, err := client.SCIM.CreateUser(context.Background(), SCIMUser{})
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error creating SCIM user: user name is required"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
+
+func TestSCIMFilterEq(t *testing.T) {
+	got := SCIMFilterEq("userName", `say "hi"\`)
+	expected := `userName eq "say \"hi\"\\"`
+	if got != expected {
+		t.Errorf("Expected filter %s, got %s", expected, got)
+	}
+}
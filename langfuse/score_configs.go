@@ -0,0 +1,205 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"time"
+)
+
+// ScoreConfigsService handles operations related to score configs
+type ScoreConfigsService service
+
+// ScoreConfig represents the schema scores of a given name must follow.
+// MinValue and MaxValue bound NUMERIC scores; Categories enumerate the allowed
+// values of CATEGORICAL scores.
+type ScoreConfig struct {
+	ID          string           `json:"id,omitempty"`
+	Name        string           `json:"name"`
+	DataType    ScoreDataType    `json:"dataType"`
+	IsArchived  bool             `json:"isArchived,omitempty"`
+	MinValue    *float64         `json:"minValue,omitempty"`
+	MaxValue    *float64         `json:"maxValue,omitempty"`
+	Categories  []ConfigCategory `json:"categories,omitempty"`
+	Description string           `json:"description,omitempty"`
+	ProjectID   string           `json:"projectId,omitempty"`
+	CreatedAt   *time.Time       `json:"createdAt,omitempty"`
+	UpdatedAt   *time.Time       `json:"updatedAt,omitempty"`
+}
+
+// ConfigCategory represents an allowed category of a categorical score config
+type ConfigCategory struct {
+	Value float64 `json:"value"`
+	Label string  `json:"label"`
+}
+
+// ScoreConfigList represents a page of score configs
+type ScoreConfigList struct {
+	Data []ScoreConfig `json:"data"`
+	Meta MetaResponse  `json:"meta"`
+}
+
+// updateScoreConfigRequest represents the request body for updating a score config
+type updateScoreConfigRequest struct {
+	IsArchived bool `json:"isArchived"`
+}
+
+// Create creates a new score config
+// https://api.reference.langfuse.com/#tag/scoreconfigs/post/api/public/score-configs
+func (s *ScoreConfigsService) Create(ctx context.Context, config ScoreConfig) (*ScoreConfig, error) {
+	u := "/api/public/score-configs"
+
+	body, err := s.client.DoWithContext(ctx, "POST", u, &config)
+	if err != nil {
+		return nil, fmt.Errorf("error creating score config: %w", err)
+	}
+
+	var created ScoreConfig
+	err = json.Unmarshal(body, &created)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling created score config data: %w", err)
+	}
+
+	return &created, nil
+}
+
+// Get retrieves a single score config
+// https://api.reference.langfuse.com/#tag/scoreconfigs/get/api/public/score-configs/{configId}
+func (s *ScoreConfigsService) Get(ctx context.Context, id string) (*ScoreConfig, error) {
+	u := fmt.Sprintf("/api/public/score-configs/%s", url.PathEscape(id))
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching score config: %w", err)
+	}
+
+	var config ScoreConfig
+	err = json.Unmarshal(body, &config)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling score config data: %w", err)
+	}
+
+	return &config, nil
+}
+
+// List retrieves a page of score configs. Zero page and limit use the server defaults.
+// https://api.reference.langfuse.com/#tag/scoreconfigs/get/api/public/score-configs
+func (s *ScoreConfigsService) List(ctx context.Context, page, limit int) (*ScoreConfigList, error) {
+	params := url.Values{}
+	setInt(params, "page", page)
+	setInt(params, "limit", limit)
+
+	u := withQuery("/api/public/score-configs", params)
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing score configs: %w", err)
+	}
+
+	var configs ScoreConfigList
+	err = json.Unmarshal(body, &configs)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling score configs data: %w", err)
+	}
+
+	return &configs, nil
+}
+
+// All iterates over all score configs, fetching pages as needed
+func (s *ScoreConfigsService) All(ctx context.Context) iter.Seq2[ScoreConfig, error] {
+	return paginate(ctx, 1, func(ctx context.Context, page int) ([]ScoreConfig, *MetaResponse, error) {
+		configs, err := s.List(ctx, page, 0)
+		if err != nil {
+			return nil, nil, err
+		}
+		return configs.Data, &configs.Meta, nil
+	})
+}
+
+// Archive archives a score config so it can no longer be used for new scores
+// https://api.reference.langfuse.com/#tag/scoreconfigs/patch/api/public/score-configs/{configId}
+func (s *ScoreConfigsService) Archive(ctx context.Context, id string) (*ScoreConfig, error) {
+	return s.setArchived(ctx, id, true)
+}
+
+// Unarchive restores an archived score config
+// https://api.reference.langfuse.com/#tag/scoreconfigs/patch/api/public/score-configs/{configId}
+func (s *ScoreConfigsService) Unarchive(ctx context.Context, id string) (*ScoreConfig, error) {
+	return s.setArchived(ctx, id, false)
+}
+
+func (s *ScoreConfigsService) setArchived(ctx context.Context, id string, archived bool) (*ScoreConfig, error) {
+	u := fmt.Sprintf("/api/public/score-configs/%s", url.PathEscape(id))
+
+	request := &updateScoreConfigRequest{
+		IsArchived: archived,
+	}
+
+	body, err := s.client.DoWithContext(ctx, "PATCH", u, request)
+	if err != nil {
+		return nil, fmt.Errorf("error updating score config: %w", err)
+	}
+
+	var config ScoreConfig
+	err = json.Unmarshal(body, &config)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling updated score config data: %w", err)
+	}
+
+	return &config, nil
+}
+
+// validateScoreAgainstConfig fills the score's config ID, name and data type from
+// the config and checks that the score conforms to it. The score must already
+// be normalized by normalizeScore except for the fields taken from the config.
+func validateScoreAgainstConfig(score *Score, config *ScoreConfig) error {
+	if config.IsArchived {
+		return scoreFieldErrorf("configId", "score config %s is archived", config.ID)
+	}
+
+	if score.ConfigID == "" {
+		score.ConfigID = config.ID
+	} else if score.ConfigID != config.ID {
+		return scoreFieldErrorf("configId", "does not match score config %s", config.ID)
+	}
+
+	if score.Name == "" {
+		score.Name = config.Name
+	} else if score.Name != config.Name {
+		return scoreFieldErrorf("name", "must be %q to match the score config", config.Name)
+	}
+
+	if score.DataType == "" {
+		score.DataType = config.DataType
+	} else if score.DataType != config.DataType {
+		return scoreFieldErrorf("dataType", "must be %s to match the score config", config.DataType)
+	}
+
+	if err := normalizeScore(score); err != nil {
+		return err
+	}
+
+	// Boolean values need no further checks; normalizeScore already restricts them to 0 and 1
+	switch config.DataType {
+	case ScoreDataTypeNumeric:
+		value, _ := score.Value.(float64)
+		if config.MinValue != nil && value < *config.MinValue {
+			return scoreValueErrorf("%v is below the minimum of %v", value, *config.MinValue)
+		}
+		if config.MaxValue != nil && value > *config.MaxValue {
+			return scoreValueErrorf("%v is above the maximum of %v", value, *config.MaxValue)
+		}
+	case ScoreDataTypeCategorical:
+		value, _ := score.Value.(string)
+		for _, category := range config.Categories {
+			if category.Label == value {
+				return nil
+			}
+		}
+		return scoreValueErrorf("%q is not a category of the score config", value)
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupScoreConfigsTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.ScoreConfigs = (*ScoreConfigsService)(&service{client: client})
+	client.Scores = (*ScoresService)(&service{client: client})
+
+	return client, server
+}
+
+func TestScoreConfigsService_Create_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Expected POST method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/api/public/score-configs" {
+			t.Errorf("Expected path /api/public/score-configs, got %s", r.URL.Path)
+		}
+
+		var config ScoreConfig
+		if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		if config.Name != "tone" || config.DataType != ScoreDataTypeCategorical || len(config.Categories) != 2 {
+			t.Errorf("Unexpected config %+v", config)
+		}
+
+		config.ID = "config-1"
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(config)
+	}
+
+	client, server := setupScoreConfigsTestClient(handler)
+	defer server.Close()
+
+	config, err := client.ScoreConfigs.Create(context.Background(), ScoreConfig{
+		Name:     "tone",
+		DataType: ScoreDataTypeCategorical,
+		Categories: []ConfigCategory{
+			{Value: 0, Label: "rude"},
+			{Value: 1, Label: "friendly"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.ID != "config-1" {
+		t.Errorf("Expected ID config-1, got %s", config.ID)
+	}
+}
+
+func TestScoreConfigsService_Get_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/score-configs/config-1" {
+			t.Errorf("Expected path /api/public/score-configs/config-1, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "config-1", "name": "accuracy", "dataType": "NUMERIC", "minValue": 0, "maxValue": 1,
+			"isArchived": false, "createdAt": "2024-01-01T00:00:00Z"}`))
+	}
+
+	client, server := setupScoreConfigsTestClient(handler)
+	defer server.Close()
+
+	config, err := client.ScoreConfigs.Get(context.Background(), "config-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if config.MinValue == nil || *config.MinValue != 0 || config.MaxValue == nil || *config.MaxValue != 1 {
+		t.Errorf("Unexpected bounds %v/%v", config.MinValue, config.MaxValue)
+	}
+}
+
+func TestScoreConfigsService_List_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") != "2" || r.URL.Query().Get("limit") != "10" {
+			t.Errorf("Unexpected query %s", r.URL.RawQuery)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [{"id": "config-1", "name": "accuracy", "dataType": "NUMERIC"}],
+			"meta": {"page": 2, "limit": 10, "totalItems": 11, "totalPages": 2}}`))
+	}
+
+	client, server := setupScoreConfigsTestClient(handler)
+	defer server.Close()
+
+	configs, err := client.ScoreConfigs.List(context.Background(), 2, 10)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(configs.Data) != 1 || configs.Meta.TotalItems != 11 {
+		t.Errorf("Unexpected configs %+v", configs)
+	}
+}
+
+func TestScoreConfigsService_Archive(t *testing.T) {
+	for _, archived := range []bool{true, false} {
+		handler := func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != "PATCH" {
+				t.Errorf("Expected PATCH method, got %s", r.Method)
+			}
+
+			var payload map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+
+			if payload["isArchived"] != archived {
+				t.Errorf("Expected isArchived %v, got %v", archived, payload["isArchived"])
+			}
+
+			w.WriteHeader(http.StatusOK)
+			json.NewEncoder(w).Encode(ScoreConfig{ID: "config-1", IsArchived: archived})
+		}
+
+		client, server := setupScoreConfigsTestClient(handler)
+
+		var config *ScoreConfig
+		var err error
+		if archived {
+			config, err = client.ScoreConfigs.Archive(context.Background(), "config-1")
+		} else {
+			config, err = client.ScoreConfigs.Unarchive(context.Background(), "config-1")
+		}
+		server.Close()
+
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if config.IsArchived != archived {
+			t.Errorf("Expected IsArchived %v, got %v", archived, config.IsArchived)
+		}
+	}
+}
+
+func TestScoresService_Create_WithScoreConfig(t *testing.T) {
+	minValue, maxValue := 0.0, 1.0
+	numeric := &ScoreConfig{ID: "num", Name: "accuracy", DataType: ScoreDataTypeNumeric, MinValue: &minValue, MaxValue: &maxValue}
+	categorical := &ScoreConfig{
+		ID:         "cat",
+		Name:       "tone",
+		DataType:   ScoreDataTypeCategorical,
+		Categories: []ConfigCategory{{Value: 0, Label: "rude"}, {Value: 1, Label: "friendly"}},
+	}
+	archived := &ScoreConfig{ID: "old", Name: "legacy", DataType: ScoreDataTypeBoolean, IsArchived: true}
+
+	tests := []struct {
+		name   string
+		score  Score
+		config *ScoreConfig
+		field  string
+	}{
+		{"numeric in range", Score{TraceID: "t", Value: 0.5}, numeric, ""},
+		{"numeric below min", Score{TraceID: "t", Value: -1}, numeric, "value"},
+		{"numeric above max", Score{TraceID: "t", Value: 2}, numeric, "value"},
+		{"categorical match", Score{TraceID: "t", Value: "friendly"}, categorical, ""},
+		{"categorical unknown", Score{TraceID: "t", Value: "sarcastic"}, categorical, "value"},
+		{"name mismatch", Score{Name: "other", TraceID: "t", Value: 0.5}, numeric, "name"},
+		{"data type mismatch", Score{TraceID: "t", DataType: ScoreDataTypeCategorical, Value: "x"}, numeric, "dataType"},
+		{"config ID mismatch", Score{TraceID: "t", ConfigID: "cat", Value: 0.5}, numeric, "configId"},
+		{"archived config", Score{TraceID: "t", Value: true}, archived, "configId"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				var payload map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+					t.Fatalf("Failed to decode request body: %v", err)
+				}
+
+				if payload["configId"] != tt.config.ID || payload["name"] != tt.config.Name {
+					t.Errorf("Expected config ID and name to be filled from config, got %v", payload)
+				}
+				if payload["dataType"] != string(tt.config.DataType) {
+					t.Errorf("Expected dataType %s, got %v", tt.config.DataType, payload["dataType"])
+				}
+
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"id": "score-1"}`))
+			}
+
+			client, server := setupScoreConfigsTestClient(handler)
+			defer server.Close()
+
+			_, err := client.Scores.Create(context.Background(), tt.score, WithScoreConfig(tt.config))
+
+			if tt.field == "" {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				return
+			}
+
+			var validationErr *ScoreValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Expected ScoreValidationError, got %v", err)
+			}
+			if validationErr.Field != tt.field {
+				t.Errorf("Expected field %s, got %s (%v)", tt.field, validationErr.Field, err)
+			}
+		})
+	}
+}
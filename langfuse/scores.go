@@ -0,0 +1,347 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ScoresService handles operations related to scores
+type ScoresService service
+
+// ScoreDataType represents the data type of a score value
+type ScoreDataType string
+
+// Score data types
+const (
+	ScoreDataTypeNumeric     ScoreDataType = "NUMERIC"
+	ScoreDataTypeCategorical ScoreDataType = "CATEGORICAL"
+	ScoreDataTypeBoolean     ScoreDataType = "BOOLEAN"
+)
+
+// ScoreSource represents how a score was produced
+type ScoreSource string
+
+// Score sources
+const (
+	ScoreSourceAPI        ScoreSource = "API"
+	ScoreSourceAnnotation ScoreSource = "ANNOTATION"
+	ScoreSourceEval       ScoreSource = "EVAL"
+)
+
+// Score represents an evaluation score attached to a trace, observation, session or dataset run.
+//
+// When creating a score, Value must match DataType: a number for NUMERIC, a string for
+// CATEGORICAL and a bool (or 0/1) for BOOLEAN. When reading scores, Value holds a float64
+// for numeric and boolean scores while categorical scores carry their category in StringValue.
+type Score struct {
+	ID            string                 `json:"id,omitempty"`
+	TraceID       string                 `json:"traceId,omitempty"`
+	ObservationID string                 `json:"observationId,omitempty"`
+	SessionID     string                 `json:"sessionId,omitempty"`
+	DatasetRunID  string                 `json:"datasetRunId,omitempty"`
+	Name          string                 `json:"name"`
+	Value         interface{}            `json:"value,omitempty"`
+	StringValue   string                 `json:"stringValue,omitempty"`
+	DataType      ScoreDataType          `json:"dataType,omitempty"`
+	Source        ScoreSource            `json:"source,omitempty"`
+	Comment       string                 `json:"comment,omitempty"`
+	ConfigID      string                 `json:"configId,omitempty"`
+	Metadata      map[string]interface{} `json:"metadata,omitempty"`
+	Environment   string                 `json:"environment,omitempty"`
+	Timestamp     *time.Time             `json:"timestamp,omitempty"`
+}
+
+// ScoreList represents a page of scores
+type ScoreList struct {
+	Data []Score      `json:"data"`
+	Meta MetaResponse `json:"meta"`
+}
+
+// ScoreFilter holds the query parameters for listing scores. Zero values are omitted.
+// Operator (e.g. ">=") and Value filter numeric scores by value and must be used together.
+type ScoreFilter struct {
+	Page          int
+	Limit         int
+	UserID        string
+	Name          string
+	FromTimestamp time.Time
+	ToTimestamp   time.Time
+	Environment   []string
+	Source        ScoreSource
+	DataType      ScoreDataType
+	ConfigID      string
+	QueueID       string
+	ScoreIDs      []string
+	TraceTags     []string
+	Operator      string
+	Value         *float64
+}
+
+// ScoreCreateOption configures optional behaviour of ScoresService.Create
+type ScoreCreateOption func(*scoreCreateOptions)
+
+type scoreCreateOptions struct {
+	config *ScoreConfig
+}
+
+// createScoreResponse represents the response of the score creation endpoint
+type createScoreResponse struct {
+	ID string `json:"id"`
+}
+
+// ScoreValidationError is returned when a score is rejected locally before being sent
+type ScoreValidationError struct {
+	Field  string
+	Reason string
+}
+
+func (e *ScoreValidationError) Error() string {
+	return fmt.Sprintf("invalid score %s: %s", e.Field, e.Reason)
+}
+
+// WithScoreConfig validates the score locally against a score config, typically
+// one fetched via ScoreConfigsService.Get, before it is sent. The score's name,
+// data type and config ID are taken from the config when unset; numeric values
+// must lie within the config's bounds and categorical values must match one of
+// its category labels.
+func WithScoreConfig(config *ScoreConfig) ScoreCreateOption {
+	return func(o *scoreCreateOptions) {
+		o.config = config
+	}
+}
+
+// Create validates the score locally and creates it, returning the score with its assigned ID.
+// Integer and boolean values are normalized to the float64 representation expected by Langfuse.
+// https://api.reference.langfuse.com/#tag/score/post/api/public/scores
+func (s *ScoresService) Create(ctx context.Context, score Score, opts ...ScoreCreateOption) (*Score, error) {
+	var options scoreCreateOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var err error
+	if options.config != nil {
+		err = validateScoreAgainstConfig(&score, options.config)
+	} else {
+		err = normalizeScore(&score)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error creating score: %w", err)
+	}
+
+	u := "/api/public/scores"
+
+	body, err := s.client.DoWithContext(ctx, "POST", u, &score)
+	if err != nil {
+		return nil, fmt.Errorf("error creating score: %w", err)
+	}
+
+	var created createScoreResponse
+	err = json.Unmarshal(body, &created)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling created score data: %w", err)
+	}
+
+	score.ID = created.ID
+
+	return &score, nil
+}
+
+// Get retrieves a single score
+// https://api.reference.langfuse.com/#tag/scorev2/get/api/public/v2/scores/{scoreId}
+func (s *ScoresService) Get(ctx context.Context, id string) (*Score, error) {
+	u := fmt.Sprintf("/api/public/v2/scores/%s", url.PathEscape(id))
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching score: %w", err)
+	}
+
+	var score Score
+	err = json.Unmarshal(body, &score)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling score data: %w", err)
+	}
+
+	return &score, nil
+}
+
+// List retrieves a page of scores matching the filter
+// https://api.reference.langfuse.com/#tag/scorev2/get/api/public/v2/scores
+func (s *ScoresService) List(ctx context.Context, filter ScoreFilter) (*ScoreList, error) {
+	u := withQuery("/api/public/v2/scores", filter.values())
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing scores: %w", err)
+	}
+
+	var scores ScoreList
+	err = json.Unmarshal(body, &scores)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling scores data: %w", err)
+	}
+
+	return &scores, nil
+}
+
+// All iterates over all scores matching the filter, fetching pages as needed
+// starting at filter.Page
+func (s *ScoresService) All(ctx context.Context, filter ScoreFilter) iter.Seq2[Score, error] {
+	return paginate(ctx, filter.Page, func(ctx context.Context, page int) ([]Score, *MetaResponse, error) {
+		filter.Page = page
+		scores, err := s.List(ctx, filter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return scores.Data, &scores.Meta, nil
+	})
+}
+
+// Delete deletes a single score
+// https://api.reference.langfuse.com/#tag/score/delete/api/public/scores/{scoreId}
+func (s *ScoresService) Delete(ctx context.Context, id string) error {
+	u := fmt.Sprintf("/api/public/scores/%s", url.PathEscape(id))
+
+	_, err := s.client.DoWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting score: %w", err)
+	}
+
+	return nil
+}
+
+// normalizeScore validates the score's name, target and value against its data
+// type, converting numeric and boolean values to float64
+func normalizeScore(score *Score) error {
+	if score.Name == "" {
+		return &ScoreValidationError{Field: "name", Reason: "is required"}
+	}
+
+	targets := 0
+	for _, id := range []string{score.TraceID, score.SessionID, score.DatasetRunID} {
+		if id != "" {
+			targets++
+		}
+	}
+	if targets != 1 {
+		return &ScoreValidationError{
+			Field:  "target",
+			Reason: "exactly one of traceId, sessionId or datasetRunId is required",
+		}
+	}
+
+	if score.ObservationID != "" && score.TraceID == "" {
+		return &ScoreValidationError{Field: "observationId", Reason: "requires traceId"}
+	}
+
+	if score.Value == nil {
+		return &ScoreValidationError{Field: "value", Reason: "is required"}
+	}
+
+	number, isNumber := toFloat64(score.Value)
+	_, isString := score.Value.(string)
+	flag, isBool := score.Value.(bool)
+
+	switch score.DataType {
+	case "":
+		if !isNumber && !isString {
+			return scoreValueErrorf("must be a number or string, got %T", score.Value)
+		}
+		if isNumber {
+			score.Value = number
+		}
+	case ScoreDataTypeNumeric:
+		if !isNumber {
+			return scoreValueErrorf("must be a number for NUMERIC scores, got %T", score.Value)
+		}
+		score.Value = number
+	case ScoreDataTypeCategorical:
+		if !isString {
+			return scoreValueErrorf("must be a string for CATEGORICAL scores, got %T", score.Value)
+		}
+	case ScoreDataTypeBoolean:
+		switch {
+		case isBool && flag:
+			score.Value = float64(1)
+		case isBool:
+			score.Value = float64(0)
+		case isNumber && (number == 0 || number == 1):
+			score.Value = number
+		default:
+			return scoreValueErrorf("must be a bool or 0/1 for BOOLEAN scores, got %v", score.Value)
+		}
+	default:
+		return &ScoreValidationError{Field: "dataType", Reason: fmt.Sprintf("unknown data type %q", score.DataType)}
+	}
+
+	return nil
+}
+
+func scoreFieldErrorf(field, format string, args ...interface{}) error {
+	return &ScoreValidationError{Field: field, Reason: fmt.Sprintf(format, args...)}
+}
+
+func scoreValueErrorf(format string, args ...interface{}) error {
+	return scoreFieldErrorf("value", format, args...)
+}
+
+// toFloat64 converts Go numeric types to float64
+func toFloat64(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int8:
+		return float64(n), true
+	case int16:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case uint:
+		return float64(n), true
+	case uint8:
+		return float64(n), true
+	case uint16:
+		return float64(n), true
+	case uint32:
+		return float64(n), true
+	case uint64:
+		return float64(n), true
+	default:
+		return 0, false
+	}
+}
+
+func (f *ScoreFilter) values() url.Values {
+	params := url.Values{}
+	setInt(params, "page", f.Page)
+	setInt(params, "limit", f.Limit)
+	setString(params, "userId", f.UserID)
+	setString(params, "name", f.Name)
+	setTime(params, "fromTimestamp", f.FromTimestamp)
+	setTime(params, "toTimestamp", f.ToTimestamp)
+	addStrings(params, "environment", f.Environment)
+	setString(params, "source", string(f.Source))
+	setString(params, "dataType", string(f.DataType))
+	setString(params, "configId", f.ConfigID)
+	setString(params, "queueId", f.QueueID)
+	setString(params, "scoreIds", strings.Join(f.ScoreIDs, ","))
+	addStrings(params, "traceTags", f.TraceTags)
+	setString(params, "operator", f.Operator)
+	if f.Value != nil {
+		params.Set("value", strconv.FormatFloat(*f.Value, 'f', -1, 64))
+	}
+	return params
+}
@@ -0,0 +1,369 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupScoresTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.Scores = (*ScoresService)(&service{client: client})
+
+	return client, server
+}
+
+func TestScoresService_Create_DataTypes(t *testing.T) {
+	tests := []struct {
+		name          string
+		score         Score
+		expectedValue interface{}
+	}{
+		{
+			name:          "numeric int",
+			score:         Score{Name: "accuracy", TraceID: "t1", DataType: ScoreDataTypeNumeric, Value: 1},
+			expectedValue: float64(1),
+		},
+		{
+			name:          "numeric float",
+			score:         Score{Name: "accuracy", TraceID: "t1", DataType: ScoreDataTypeNumeric, Value: 0.75},
+			expectedValue: 0.75,
+		},
+		{
+			name:          "categorical",
+			score:         Score{Name: "tone", TraceID: "t1", DataType: ScoreDataTypeCategorical, Value: "friendly"},
+			expectedValue: "friendly",
+		},
+		{
+			name:          "boolean true",
+			score:         Score{Name: "correct", TraceID: "t1", DataType: ScoreDataTypeBoolean, Value: true},
+			expectedValue: float64(1),
+		},
+		{
+			name:          "boolean zero",
+			score:         Score{Name: "correct", TraceID: "t1", DataType: ScoreDataTypeBoolean, Value: 0},
+			expectedValue: float64(0),
+		},
+		{
+			name:          "inferred",
+			score:         Score{Name: "latency", SessionID: "s1", Value: int64(42)},
+			expectedValue: float64(42),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "POST" {
+					t.Errorf("Expected POST method, got %s", r.Method)
+				}
+
+				if r.URL.Path != "/api/public/scores" {
+					t.Errorf("Expected path /api/public/scores, got %s", r.URL.Path)
+				}
+
+				var payload map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+					t.Fatalf("Failed to decode request body: %v", err)
+				}
+
+				if payload["value"] != tt.expectedValue {
+					t.Errorf("Expected value %v, got %v", tt.expectedValue, payload["value"])
+				}
+
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"id": "score-1"}`))
+			}
+
+			client, server := setupScoresTestClient(handler)
+			defer server.Close()
+
+			score, err := client.Scores.Create(context.Background(), tt.score)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if score.ID != "score-1" {
+				t.Errorf("Expected ID score-1, got %s", score.ID)
+			}
+		})
+	}
+}
+
+func TestScoresService_Create_Targets(t *testing.T) {
+	tests := []struct {
+		name     string
+		score    Score
+		expected map[string]interface{}
+	}{
+		{
+			name: "observation",
+			score: Score{
+				TraceID:       "trace-1",
+				ObservationID: "obs-1",
+				ConfigID:      "config-1",
+				Comment:       "looks good",
+				DataType:      ScoreDataTypeNumeric,
+			},
+			expected: map[string]interface{}{
+				"traceId":       "trace-1",
+				"observationId": "obs-1",
+				"configId":      "config-1",
+				"comment":       "looks good",
+				"dataType":      "NUMERIC",
+			},
+		},
+		{
+			name:     "session",
+			score:    Score{SessionID: "session-1"},
+			expected: map[string]interface{}{"sessionId": "session-1"},
+		},
+		{
+			name:     "dataset run",
+			score:    Score{DatasetRunID: "run-1"},
+			expected: map[string]interface{}{"datasetRunId": "run-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				var payload map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+					t.Fatalf("Failed to decode request body: %v", err)
+				}
+
+				for key, value := range tt.expected {
+					if payload[key] != value {
+						t.Errorf("Expected %s=%v, got %v", key, value, payload[key])
+					}
+				}
+
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(`{"id": "score-1"}`))
+			}
+
+			client, server := setupScoresTestClient(handler)
+			defer server.Close()
+
+			score := tt.score
+			score.Name = "quality"
+			score.Value = 0.8
+
+			if _, err := client.Scores.Create(context.Background(), score); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestScoresService_Create_MultipleTargets(t *testing.T) {
+	tests := []struct {
+		name  string
+		score Score
+	}{
+		{"trace and session", Score{TraceID: "t", SessionID: "s"}},
+		{"trace and dataset run", Score{TraceID: "t", DatasetRunID: "r"}},
+		{"observation and dataset run", Score{TraceID: "t", ObservationID: "o", DatasetRunID: "r"}},
+		{"session and dataset run", Score{SessionID: "s", DatasetRunID: "r"}},
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request for a score with multiple targets")
+	}
+
+	client, server := setupScoresTestClient(handler)
+	defer server.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			score := tt.score
+			score.Name = "quality"
+			score.Value = 0.8
+
+			_, err := client.Scores.Create(context.Background(), score)
+
+			var validationErr *ScoreValidationError
+			if !errors.As(err, &validationErr) || validationErr.Field != "target" {
+				t.Fatalf("Expected target validation error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestScoresService_Create_ValidationErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		score Score
+		field string
+	}{
+		{"missing name", Score{TraceID: "t", Value: 1}, "name"},
+		{"missing target", Score{Name: "n", Value: 1}, "target"},
+		{"observation without trace", Score{Name: "n", SessionID: "s", ObservationID: "o", Value: 1}, "observationId"},
+		{"missing value", Score{Name: "n", TraceID: "t"}, "value"},
+		{"numeric with string", Score{Name: "n", TraceID: "t", DataType: ScoreDataTypeNumeric, Value: "high"}, "value"},
+		{"categorical with number", Score{Name: "n", TraceID: "t", DataType: ScoreDataTypeCategorical, Value: 1}, "value"},
+		{"boolean out of range", Score{Name: "n", TraceID: "t", DataType: ScoreDataTypeBoolean, Value: 2}, "value"},
+		{"boolean with string", Score{Name: "n", TraceID: "t", DataType: ScoreDataTypeBoolean, Value: "yes"}, "value"},
+		{"inferred with bool", Score{Name: "n", TraceID: "t", Value: true}, "value"},
+		{"unknown data type", Score{Name: "n", TraceID: "t", DataType: "TEXT", Value: "x"}, "dataType"},
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request for invalid score")
+	}
+
+	client, server := setupScoresTestClient(handler)
+	defer server.Close()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.Scores.Create(context.Background(), tt.score)
+
+			var validationErr *ScoreValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Expected ScoreValidationError, got %v", err)
+			}
+
+			if validationErr.Field != tt.field {
+				t.Errorf("Expected field %s, got %s", tt.field, validationErr.Field)
+			}
+		})
+	}
+}
+
+func TestScoresService_Get_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/v2/scores/score-1" {
+			t.Errorf("Expected path /api/public/v2/scores/score-1, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "score-1", "name": "tone", "dataType": "CATEGORICAL", "stringValue": "friendly",
+			"source": "ANNOTATION", "traceId": "trace-1", "timestamp": "2024-01-01T00:00:00Z"}`))
+	}
+
+	client, server := setupScoresTestClient(handler)
+	defer server.Close()
+
+	score, err := client.Scores.Get(context.Background(), "score-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if score.StringValue != "friendly" || score.Source != ScoreSourceAnnotation || score.Timestamp == nil {
+		t.Errorf("Unexpected score %+v", score)
+	}
+}
+
+func TestScoresService_List_Filter(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/v2/scores" {
+			t.Errorf("Expected path /api/public/v2/scores, got %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		expected := map[string][]string{
+			"name":      {"quality"},
+			"dataType":  {"NUMERIC"},
+			"source":    {"API"},
+			"configId":  {"config-1"},
+			"scoreIds":  {"a,b"},
+			"traceTags": {"prod"},
+			"operator":  {">="},
+			"value":     {"0.5"},
+		}
+		for key, values := range expected {
+			if !reflect.DeepEqual(query[key], values) {
+				t.Errorf("Expected %s=%v, got %v", key, values, query[key])
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [{"id": "a", "name": "quality", "value": 0.9}],
+			"meta": {"page": 1, "limit": 50, "totalItems": 1, "totalPages": 1}}`))
+	}
+
+	client, server := setupScoresTestClient(handler)
+	defer server.Close()
+
+	threshold := 0.5
+	scores, err := client.Scores.List(context.Background(), ScoreFilter{
+		Name:      "quality",
+		DataType:  ScoreDataTypeNumeric,
+		Source:    ScoreSourceAPI,
+		ConfigID:  "config-1",
+		ScoreIDs:  []string{"a", "b"},
+		TraceTags: []string{"prod"},
+		Operator:  ">=",
+		Value:     &threshold,
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(scores.Data) != 1 || scores.Data[0].Value != 0.9 {
+		t.Errorf("Unexpected scores %+v", scores.Data)
+	}
+}
+
+func TestScoresService_Delete(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Expected DELETE method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/api/public/scores/score-1" {
+			t.Errorf("Expected path /api/public/scores/score-1, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	client, server := setupScoresTestClient(handler)
+	defer server.Close()
+
+	if err := client.Scores.Delete(context.Background(), "score-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestScoresService_Delete_Error(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("not found"))
+	}
+
+	client, server := setupScoresTestClient(handler)
+	defer server.Close()
+
+	err := client.Scores.Delete(context.Background(), "missing")
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error deleting score: client error 404: not found"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
@@ -0,0 +1,107 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/url"
+	"time"
+)
+
+// SessionsService handles operations related to sessions
+type SessionsService service
+
+// Session represents a group of traces sharing a session ID, e.g. the turns of a conversation
+type Session struct {
+	ID          string    `json:"id"`
+	CreatedAt   time.Time `json:"createdAt"`
+	ProjectID   string    `json:"projectId,omitempty"`
+	Environment string    `json:"environment,omitempty"`
+}
+
+// SessionWithTraces represents a session including all of its traces
+type SessionWithTraces struct {
+	Session
+	Traces []Trace `json:"traces"`
+}
+
+// SessionList represents a page of sessions
+type SessionList struct {
+	Data []Session    `json:"data"`
+	Meta MetaResponse `json:"meta"`
+}
+
+type sessionIDContextKey struct{}
+
+// ContextWithSessionID returns a copy of ctx carrying the session ID. Traces sent
+// through IngestionService.Batch with this context are assigned to the session
+// unless they set a session ID themselves.
+func ContextWithSessionID(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, sessionIDContextKey{}, sessionID)
+}
+
+// SessionIDFromContext returns the session ID carried by ctx, if any
+func SessionIDFromContext(ctx context.Context) string {
+	sessionID, _ := ctx.Value(sessionIDContextKey{}).(string)
+	return sessionID
+}
+
+// List retrieves the first page of sessions created within the given time range.
+// Zero times leave the respective bound open.
+// https://api.reference.langfuse.com/#tag/sessions/get/api/public/sessions
+func (s *SessionsService) List(ctx context.Context, from, to time.Time) (*SessionList, error) {
+	return s.listPage(ctx, from, to, 0)
+}
+
+// All iterates over all sessions created within the given time range, fetching pages as needed
+func (s *SessionsService) All(ctx context.Context, from, to time.Time) iter.Seq2[Session, error] {
+	return paginate(ctx, 1, func(ctx context.Context, page int) ([]Session, *MetaResponse, error) {
+		sessions, err := s.listPage(ctx, from, to, page)
+		if err != nil {
+			return nil, nil, err
+		}
+		return sessions.Data, &sessions.Meta, nil
+	})
+}
+
+// Get retrieves a session including its traces
+// https://api.reference.langfuse.com/#tag/sessions/get/api/public/sessions/{sessionId}
+func (s *SessionsService) Get(ctx context.Context, sessionID string) (*SessionWithTraces, error) {
+	u := fmt.Sprintf("/api/public/sessions/%s", url.PathEscape(sessionID))
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching session: %w", err)
+	}
+
+	var session SessionWithTraces
+	err = json.Unmarshal(body, &session)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling session data: %w", err)
+	}
+
+	return &session, nil
+}
+
+func (s *SessionsService) listPage(ctx context.Context, from, to time.Time, page int) (*SessionList, error) {
+	params := url.Values{}
+	setInt(params, "page", page)
+	setTime(params, "fromTimestamp", from)
+	setTime(params, "toTimestamp", to)
+
+	u := withQuery("/api/public/sessions", params)
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing sessions: %w", err)
+	}
+
+	var sessions SessionList
+	err = json.Unmarshal(body, &sessions)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling sessions data: %w", err)
+	}
+
+	return &sessions, nil
+}
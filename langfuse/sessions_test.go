@@ -0,0 +1,178 @@
+package langfuse
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupSessionsTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.Sessions = (*SessionsService)(&service{client: client})
+
+	return client, server
+}
+
+func TestSessionsService_List_Success(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/sessions" {
+			t.Errorf("Expected path /api/public/sessions, got %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		if query.Get("fromTimestamp") != "2024-01-01T00:00:00Z" {
+			t.Errorf("Unexpected fromTimestamp %s", query.Get("fromTimestamp"))
+		}
+		if query.Get("toTimestamp") != "2024-01-31T00:00:00Z" {
+			t.Errorf("Unexpected toTimestamp %s", query.Get("toTimestamp"))
+		}
+		if query.Has("page") {
+			t.Errorf("Expected no page parameter, got %s", query.Get("page"))
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"data": [{"id": "session-1", "createdAt": "2024-01-02T00:00:00Z", "projectId": "p1"}],
+			"meta": {"page": 1, "limit": 50, "totalItems": 1, "totalPages": 1}
+		}`))
+	}
+
+	client, server := setupSessionsTestClient(handler)
+	defer server.Close()
+
+	sessions, err := client.Sessions.List(context.Background(), from, to)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(sessions.Data) != 1 || sessions.Data[0].ID != "session-1" || sessions.Data[0].ProjectID != "p1" {
+		t.Errorf("Unexpected sessions %+v", sessions.Data)
+	}
+}
+
+func TestSessionsService_List_OpenRange(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("Expected no query parameters, got %s", r.URL.RawQuery)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [], "meta": {"page": 1, "limit": 50, "totalItems": 0, "totalPages": 0}}`))
+	}
+
+	client, server := setupSessionsTestClient(handler)
+	defer server.Close()
+
+	if _, err := client.Sessions.List(context.Background(), time.Time{}, time.Time{}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestSessionsService_All_Paginates(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"data": [{"id": "session-%s"}], "meta": {"page": %s, "totalPages": 3}}`, page, page)
+	}
+
+	client, server := setupSessionsTestClient(handler)
+	defer server.Close()
+
+	count := 0
+	for session, err := range client.Sessions.All(context.Background(), time.Time{}, time.Time{}) {
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		count++
+		if session.ID != fmt.Sprintf("session-%d", count) {
+			t.Errorf("Unexpected session %s", session.ID)
+		}
+	}
+
+	if count != 3 {
+		t.Errorf("Expected 3 sessions, got %d", count)
+	}
+}
+
+func TestSessionsService_Get_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.EscapedPath() != "/api/public/sessions/chat%2F42" {
+			t.Errorf("Expected escaped session path, got %s", r.URL.EscapedPath())
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"id": "chat/42",
+			"createdAt": "2024-01-02T00:00:00Z",
+			"traces": [
+				{"id": "trace-1", "timestamp": "2024-01-02T00:00:00Z", "input": "hi"},
+				{"id": "trace-2", "timestamp": "2024-01-02T00:01:00Z", "input": "bye"}
+			]
+		}`))
+	}
+
+	client, server := setupSessionsTestClient(handler)
+	defer server.Close()
+
+	session, err := client.Sessions.Get(context.Background(), "chat/42")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if session.ID != "chat/42" || len(session.Traces) != 2 || session.Traces[1].Input != "bye" {
+		t.Errorf("Unexpected session %+v", session)
+	}
+}
+
+func TestSessionsService_Get_Error(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("not found"))
+	}
+
+	client, server := setupSessionsTestClient(handler)
+	defer server.Close()
+
+	_, err := client.Sessions.Get(context.Background(), "missing")
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error fetching session: client error 404: not found"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
+
+func TestSessionIDFromContext(t *testing.T) {
+	if id := SessionIDFromContext(context.Background()); id != "" {
+		t.Errorf("Expected empty session ID, got %s", id)
+	}
+
+	ctx := ContextWithSessionID(context.Background(), "session-1")
+	if id := SessionIDFromContext(ctx); id != "session-1" {
+		t.Errorf("Expected session-1, got %s", id)
+	}
+}
@@ -0,0 +1,239 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/url"
+	"slices"
+	"time"
+)
+
+// TracesService handles operations related to traces
+type TracesService service
+
+// Trace represents a trace as returned by the list endpoint, where observations
+// and scores are referenced by ID
+type Trace struct {
+	ID           string                 `json:"id"`
+	Timestamp    time.Time              `json:"timestamp"`
+	Name         string                 `json:"name,omitempty"`
+	UserID       string                 `json:"userId,omitempty"`
+	SessionID    string                 `json:"sessionId,omitempty"`
+	Input        interface{}            `json:"input,omitempty"`
+	Output       interface{}            `json:"output,omitempty"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+	Release      string                 `json:"release,omitempty"`
+	Version      string                 `json:"version,omitempty"`
+	Tags         []string               `json:"tags,omitempty"`
+	Public       bool                   `json:"public,omitempty"`
+	Environment  string                 `json:"environment,omitempty"`
+	HTMLPath     string                 `json:"htmlPath,omitempty"`
+	Latency      float64                `json:"latency,omitempty"`
+	TotalCost    float64                `json:"totalCost,omitempty"`
+	Observations []string               `json:"observations,omitempty"`
+	Scores       []string               `json:"scores,omitempty"`
+}
+
+// TraceWithDetails represents a single trace including its full observations and scores
+type TraceWithDetails struct {
+	Trace
+	Observations []Observation `json:"observations,omitempty"`
+	Scores       []Score       `json:"scores,omitempty"`
+}
+
+// TraceList represents a page of traces
+type TraceList struct {
+	Data []Trace      `json:"data"`
+	Meta MetaResponse `json:"meta"`
+}
+
+// TraceFilter holds the query parameters for listing traces. Zero values are omitted.
+// OrderBy has the form "[field].[asc|desc]", e.g. "timestamp.desc".
+type TraceFilter struct {
+	Page          int
+	Limit         int
+	UserID        string
+	Name          string
+	SessionID     string
+	FromTimestamp time.Time
+	ToTimestamp   time.Time
+	OrderBy       string
+	Tags          []string
+	Version       string
+	Release       string
+	Environment   []string
+}
+
+// DeleteTracesRequest represents the request body for deleting multiple traces
+type DeleteTracesRequest struct {
+	TraceIDs []string `json:"traceIds"`
+}
+
+// PurgeOptions configures PurgeUserData. BatchSize is the number of traces per
+// delete request and defaults to 100. With DryRun set, traces are only looked up.
+// Progress, if set, is called after each deleted batch.
+type PurgeOptions struct {
+	BatchSize int
+	DryRun    bool
+	Progress  func(PurgeProgress)
+}
+
+// PurgeProgress reports how many of the traces found have been deleted so far
+type PurgeProgress struct {
+	Total   int
+	Deleted int
+}
+
+// PurgeResult reports the traces found for a user and how many were deleted
+type PurgeResult struct {
+	TraceIDs []string
+	Deleted  int
+	DryRun   bool
+}
+
+// Get retrieves a single trace including its observations and scores
+// https://api.reference.langfuse.com/#tag/trace/get/api/public/traces/{traceId}
+func (s *TracesService) Get(ctx context.Context, id string) (*TraceWithDetails, error) {
+	u := fmt.Sprintf("/api/public/traces/%s", url.PathEscape(id))
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching trace: %w", err)
+	}
+
+	var trace TraceWithDetails
+	err = json.Unmarshal(body, &trace)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling trace data: %w", err)
+	}
+
+	return &trace, nil
+}
+
+// List retrieves a page of traces matching the filter
+// https://api.reference.langfuse.com/#tag/trace/get/api/public/traces
+func (s *TracesService) List(ctx context.Context, filter TraceFilter) (*TraceList, error) {
+	u := withQuery("/api/public/traces", filter.values())
+
+	body, err := s.client.DoWithContext(ctx, "GET", u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error listing traces: %w", err)
+	}
+
+	var traces TraceList
+	err = json.Unmarshal(body, &traces)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling traces data: %w", err)
+	}
+
+	return &traces, nil
+}
+
+// All iterates over all traces matching the filter, fetching pages as needed
+// starting at filter.Page
+func (s *TracesService) All(ctx context.Context, filter TraceFilter) iter.Seq2[Trace, error] {
+	return paginate(ctx, filter.Page, func(ctx context.Context, page int) ([]Trace, *MetaResponse, error) {
+		filter.Page = page
+		traces, err := s.List(ctx, filter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return traces.Data, &traces.Meta, nil
+	})
+}
+
+// Delete deletes a single trace
+// https://api.reference.langfuse.com/#tag/trace/delete/api/public/traces/{traceId}
+func (s *TracesService) Delete(ctx context.Context, id string) error {
+	u := fmt.Sprintf("/api/public/traces/%s", url.PathEscape(id))
+
+	_, err := s.client.DoWithContext(ctx, "DELETE", u, nil)
+	if err != nil {
+		return fmt.Errorf("error deleting trace: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteMany deletes multiple traces in a single request
+// https://api.reference.langfuse.com/#tag/trace/delete/api/public/traces
+func (s *TracesService) DeleteMany(ctx context.Context, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	u := "/api/public/traces"
+
+	request := &DeleteTracesRequest{
+		TraceIDs: ids,
+	}
+
+	_, err := s.client.DoWithContext(ctx, "DELETE", u, request)
+	if err != nil {
+		return fmt.Errorf("error deleting traces: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeUserData deletes all traces of a user, including their observations and
+// scores. All trace IDs are collected before deleting, so pagination is not
+// affected by the deletions. On error, the result reports the traces deleted so
+// far, which is none if looking up the traces fails. Langfuse deletes traces
+// asynchronously, so they may briefly remain visible.
+func (s *TracesService) PurgeUserData(ctx context.Context, userID string, opts PurgeOptions) (*PurgeResult, error) {
+	if userID == "" {
+		return nil, errors.New("error purging user data: user ID is required")
+	}
+
+	batchSize := opts.BatchSize
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+
+	result := &PurgeResult{DryRun: opts.DryRun}
+	for trace, err := range s.All(ctx, TraceFilter{UserID: userID, Limit: 100}) {
+		if err != nil {
+			return result, fmt.Errorf("error purging user data: %w", err)
+		}
+		result.TraceIDs = append(result.TraceIDs, trace.ID)
+	}
+
+	if opts.DryRun {
+		return result, nil
+	}
+
+	for batch := range slices.Chunk(result.TraceIDs, batchSize) {
+		err := s.DeleteMany(ctx, batch)
+		if err != nil {
+			return result, fmt.Errorf("error purging user data: %w", err)
+		}
+
+		result.Deleted += len(batch)
+		if opts.Progress != nil {
+			opts.Progress(PurgeProgress{Total: len(result.TraceIDs), Deleted: result.Deleted})
+		}
+	}
+
+	return result, nil
+}
+
+func (f *TraceFilter) values() url.Values {
+	params := url.Values{}
+	setInt(params, "page", f.Page)
+	setInt(params, "limit", f.Limit)
+	setString(params, "userId", f.UserID)
+	setString(params, "name", f.Name)
+	setString(params, "sessionId", f.SessionID)
+	setTime(params, "fromTimestamp", f.FromTimestamp)
+	setTime(params, "toTimestamp", f.ToTimestamp)
+	setString(params, "orderBy", f.OrderBy)
+	addStrings(params, "tags", f.Tags)
+	setString(params, "version", f.Version)
+	setString(params, "release", f.Release)
+	addStrings(params, "environment", f.Environment)
+	return params
+}
@@ -0,0 +1,467 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+func setupTracesTestClient(handler http.HandlerFunc) (*Client, *httptest.Server) {
+	server := httptest.NewServer(handler)
+
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 1
+	retryClient.RetryWaitMin = 1 * time.Millisecond
+	retryClient.RetryWaitMax = 10 * time.Millisecond
+	retryClient.Logger = nil
+
+	client := &Client{
+		retryableClient: retryClient,
+		baseUrl:         server.URL,
+		base64Token:     "test-token",
+	}
+
+	client.Traces = (*TracesService)(&service{client: client})
+
+	return client, server
+}
+
+func TestTracesService_Get_Success(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("Expected GET method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/api/public/traces/trace-1" {
+			t.Errorf("Expected path /api/public/traces/trace-1, got %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"id": "trace-1",
+			"timestamp": "2024-01-01T00:00:00.000Z",
+			"name": "chat",
+			"userId": "user-1",
+			"sessionId": "session-1",
+			"input": {"question": "hi"},
+			"tags": ["prod"],
+			"latency": 1.5,
+			"totalCost": 0.002,
+			"htmlPath": "/project/p/traces/trace-1",
+			"observations": [
+				{"id": "obs-1", "traceId": "trace-1", "type": "GENERATION", "name": "completion",
+				 "startTime": "2024-01-01T00:00:00.100Z", "level": "DEFAULT"}
+			],
+			"scores": [
+				{"id": "score-1", "traceId": "trace-1", "name": "quality", "value": 0.9,
+				 "dataType": "NUMERIC", "source": "API"}
+			]
+		}`))
+	}
+
+	client, server := setupTracesTestClient(handler)
+	defer server.Close()
+
+	trace, err := client.Traces.Get(context.Background(), "trace-1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if trace.ID != "trace-1" || trace.Name != "chat" || trace.SessionID != "session-1" {
+		t.Errorf("Unexpected trace %+v", trace.Trace)
+	}
+
+	if !trace.Timestamp.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected timestamp %v", trace.Timestamp)
+	}
+
+	if trace.Latency != 1.5 || trace.TotalCost != 0.002 {
+		t.Errorf("Unexpected latency/cost %v/%v", trace.Latency, trace.TotalCost)
+	}
+
+	if len(trace.Observations) != 1 || trace.Observations[0].Type != ObservationTypeGeneration {
+		t.Fatalf("Expected one generation observation, got %+v", trace.Observations)
+	}
+
+	if len(trace.Scores) != 1 || trace.Scores[0].Value != 0.9 || trace.Scores[0].DataType != ScoreDataTypeNumeric {
+		t.Errorf("Unexpected scores %+v", trace.Scores)
+	}
+}
+
+func TestTracesService_Get_NotFound(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("not found"))
+	}
+
+	client, server := setupTracesTestClient(handler)
+	defer server.Close()
+
+	_, err := client.Traces.Get(context.Background(), "missing")
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error fetching trace: client error 404: not found"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
+
+func TestTracesService_List_Filter(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/public/traces" {
+			t.Errorf("Expected path /api/public/traces, got %s", r.URL.Path)
+		}
+
+		query := r.URL.Query()
+		expected := map[string][]string{
+			"page":          {"2"},
+			"limit":         {"10"},
+			"userId":        {"user-1"},
+			"name":          {"chat"},
+			"sessionId":     {"session-1"},
+			"fromTimestamp": {"2024-01-01T00:00:00Z"},
+			"toTimestamp":   {"2024-01-02T00:00:00Z"},
+			"orderBy":       {"timestamp.desc"},
+			"tags":          {"a", "b"},
+			"version":       {"v1"},
+			"release":       {"r1"},
+			"environment":   {"production", "staging"},
+		}
+		for key, values := range expected {
+			if !reflect.DeepEqual(query[key], values) {
+				t.Errorf("Expected %s=%v, got %v", key, values, query[key])
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"data": [{"id": "trace-1", "timestamp": "2024-01-01T00:00:00Z", "observations": ["obs-1"], "scores": []}],
+			"meta": {"page": 2, "limit": 10, "totalItems": 11, "totalPages": 2}
+		}`))
+	}
+
+	client, server := setupTracesTestClient(handler)
+	defer server.Close()
+
+	traces, err := client.Traces.List(context.Background(), TraceFilter{
+		Page:          2,
+		Limit:         10,
+		UserID:        "user-1",
+		Name:          "chat",
+		SessionID:     "session-1",
+		FromTimestamp: from,
+		ToTimestamp:   to,
+		OrderBy:       "timestamp.desc",
+		Tags:          []string{"a", "b"},
+		Version:       "v1",
+		Release:       "r1",
+		Environment:   []string{"production", "staging"},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(traces.Data) != 1 || traces.Data[0].Observations[0] != "obs-1" {
+		t.Errorf("Unexpected traces %+v", traces.Data)
+	}
+
+	if traces.Meta.TotalItems != 11 || traces.Meta.TotalPages != 2 {
+		t.Errorf("Unexpected meta %+v", traces.Meta)
+	}
+}
+
+func TestTracesService_List_NoFilter(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("Expected no query parameters, got %s", r.URL.RawQuery)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [], "meta": {"page": 1, "limit": 50, "totalItems": 0, "totalPages": 0}}`))
+	}
+
+	client, server := setupTracesTestClient(handler)
+	defer server.Close()
+
+	if _, err := client.Traces.List(context.Background(), TraceFilter{}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestTracesService_All_Paginates(t *testing.T) {
+	requests := 0
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page := r.URL.Query().Get("page")
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{
+			"data": [{"id": "trace-%s-a"}, {"id": "trace-%s-b"}],
+			"meta": {"page": %s, "limit": 2, "totalItems": 6, "totalPages": 3}
+		}`, page, page, page)
+	}
+
+	client, server := setupTracesTestClient(handler)
+	defer server.Close()
+
+	var ids []string
+	for trace, err := range client.Traces.All(context.Background(), TraceFilter{Limit: 2}) {
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		ids = append(ids, trace.ID)
+	}
+
+	expected := []string{"trace-1-a", "trace-1-b", "trace-2-a", "trace-2-b", "trace-3-a", "trace-3-b"}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("Expected %v, got %v", expected, ids)
+	}
+
+	if requests != 3 {
+		t.Errorf("Expected 3 requests, got %d", requests)
+	}
+}
+
+func TestTracesService_All_StopsEarly(t *testing.T) {
+	requests := 0
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"data": [{"id": "a"}, {"id": "b"}], "meta": {"page": 1, "limit": 2, "totalPages": 5}}`))
+	}
+
+	client, server := setupTracesTestClient(handler)
+	defer server.Close()
+
+	for range client.Traces.All(context.Background(), TraceFilter{}) {
+		break
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected 1 request, got %d", requests)
+	}
+}
+
+func TestTracesService_All_Error(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("bad request"))
+	}
+
+	client, server := setupTracesTestClient(handler)
+	defer server.Close()
+
+	count := 0
+	for _, err := range client.Traces.All(context.Background(), TraceFilter{}) {
+		count++
+		if err == nil {
+			t.Error("Expected error, got nil")
+		}
+	}
+
+	if count != 1 {
+		t.Errorf("Expected a single error yield, got %d", count)
+	}
+}
+
+func TestTracesService_Delete(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Expected DELETE method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/api/public/traces/trace-1" {
+			t.Errorf("Expected path /api/public/traces/trace-1, got %s", r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message": "Trace deleted successfully"}`))
+	}
+
+	client, server := setupTracesTestClient(handler)
+	defer server.Close()
+
+	if err := client.Traces.Delete(context.Background(), "trace-1"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestTracesService_DeleteMany(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("Expected DELETE method, got %s", r.Method)
+		}
+
+		if r.URL.Path != "/api/public/traces" {
+			t.Errorf("Expected path /api/public/traces, got %s", r.URL.Path)
+		}
+
+		var request DeleteTracesRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatalf("Failed to decode request body: %v", err)
+		}
+
+		if !reflect.DeepEqual(request.TraceIDs, []string{"a", "b"}) {
+			t.Errorf("Expected traceIds [a b], got %v", request.TraceIDs)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message": "Traces deleted successfully"}`))
+	}
+
+	client, server := setupTracesTestClient(handler)
+	defer server.Close()
+
+	if err := client.Traces.DeleteMany(context.Background(), []string{"a", "b"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func TestTracesService_DeleteMany_Error(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("forbidden"))
+	}
+
+	client, server := setupTracesTestClient(handler)
+	defer server.Close()
+
+	err := client.Traces.DeleteMany(context.Background(), []string{"a"})
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error deleting traces: client error 403: forbidden"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+}
+
+// purgeTestHandler serves two pages of traces for user-1 and records delete batches
+func purgeTestHandler(t *testing.T, deleted *[][]string, failBatch int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "GET":
+			if r.URL.Query().Get("userId") != "user-1" {
+				t.Errorf("Expected userId user-1, got %s", r.URL.RawQuery)
+			}
+			w.WriteHeader(http.StatusOK)
+			if r.URL.Query().Get("page") == "1" {
+				w.Write([]byte(`{"data": [{"id": "t1"}, {"id": "t2"}, {"id": "t3"}],
+					"meta": {"page": 1, "totalPages": 2}}`))
+			} else {
+				w.Write([]byte(`{"data": [{"id": "t4"}, {"id": "t5"}], "meta": {"page": 2, "totalPages": 2}}`))
+			}
+		case "DELETE":
+			if len(*deleted) == failBatch {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte("forbidden"))
+				return
+			}
+			var request DeleteTracesRequest
+			if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+				t.Fatalf("Failed to decode request body: %v", err)
+			}
+			*deleted = append(*deleted, request.TraceIDs)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"message": "Traces deleted successfully"}`))
+		}
+	}
+}
+
+func TestTracesService_PurgeUserData(t *testing.T) {
+	var deleted [][]string
+	client, server := setupTracesTestClient(purgeTestHandler(t, &deleted, -1))
+	defer server.Close()
+
+	var progress []PurgeProgress
+	result, err := client.Traces.PurgeUserData(context.Background(), "user-1", PurgeOptions{
+		BatchSize: 2,
+		Progress:  func(p PurgeProgress) { progress = append(progress, p) },
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expectedBatches := [][]string{{"t1", "t2"}, {"t3", "t4"}, {"t5"}}
+	if !reflect.DeepEqual(deleted, expectedBatches) {
+		t.Errorf("Expected batches %v, got %v", expectedBatches, deleted)
+	}
+
+	if result.Deleted != 5 || len(result.TraceIDs) != 5 || result.DryRun {
+		t.Errorf("Unexpected result %+v", result)
+	}
+
+	expectedProgress := []PurgeProgress{{Total: 5, Deleted: 2}, {Total: 5, Deleted: 4}, {Total: 5, Deleted: 5}}
+	if !reflect.DeepEqual(progress, expectedProgress) {
+		t.Errorf("Expected progress %v, got %v", expectedProgress, progress)
+	}
+}
+
+func TestTracesService_PurgeUserData_DryRun(t *testing.T) {
+	var deleted [][]string
+	client, server := setupTracesTestClient(purgeTestHandler(t, &deleted, -1))
+	defer server.Close()
+
+	result, err := client.Traces.PurgeUserData(context.Background(), "user-1", PurgeOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(deleted) != 0 {
+		t.Errorf("Expected no deletions in dry run, got %v", deleted)
+	}
+
+	if !reflect.DeepEqual(result.TraceIDs, []string{"t1", "t2", "t3", "t4", "t5"}) || result.Deleted != 0 {
+		t.Errorf("Unexpected result %+v", result)
+	}
+}
+
+func TestTracesService_PurgeUserData_PartialFailure(t *testing.T) {
+	var deleted [][]string
+	client, server := setupTracesTestClient(purgeTestHandler(t, &deleted, 1))
+	defer server.Close()
+
+	result, err := client.Traces.PurgeUserData(context.Background(), "user-1", PurgeOptions{BatchSize: 3})
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+
+	expectedError := "error purging user data: error deleting traces: client error 403: forbidden"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+
+	if result == nil || result.Deleted != 3 {
+		t.Errorf("Expected 3 traces deleted before the failure, got %+v", result)
+	}
+}
+
+func TestTracesService_PurgeUserData_RequiresUserID(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected no request without a user ID")
+	}
+
+	client, server := setupTracesTestClient(handler)
+	defer server.Close()
+
+	_, err := client.Traces.PurgeUserData(context.Background(), "", PurgeOptions{})
+	if err == nil || err.Error() != "error purging user data: user ID is required" {
+		t.Errorf("Expected user ID error, got %v", err)
+	}
+}
@@ -0,0 +1,131 @@
+package langfuse
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Well-known keys of the Langfuse usageDetails and costDetails maps
+const (
+	UsageKeyInput     = "input"
+	UsageKeyOutput    = "output"
+	UsageKeyTotal     = "total"
+	UsageKeyCached    = "input_cached_tokens"
+	UsageKeyReasoning = "output_reasoning_tokens"
+)
+
+// Usage represents the token accounting of a generation. It is sent to and
+// read from Langfuse as the flat usageDetails map; Other holds any additional
+// provider-specific keys (e.g. "input_audio_tokens").
+type Usage struct {
+	Input     int
+	Output    int
+	Total     int
+	Cached    int
+	Reasoning int
+	Other     map[string]int
+}
+
+// Cost represents the cost of a generation in USD. It is sent to and read from
+// Langfuse as the flat costDetails map; Other holds any additional keys matching
+// the keys used in Usage.
+type Cost struct {
+	Input  float64
+	Output float64
+	Total  float64
+	Other  map[string]float64
+}
+
+// MarshalJSON encodes the usage as a Langfuse usageDetails map, omitting zero counts
+func (u Usage) MarshalJSON() ([]byte, error) {
+	details := make(map[string]int, len(u.Other)+5)
+	for k, v := range u.Other {
+		details[k] = v
+	}
+
+	setNonZero(details, UsageKeyInput, u.Input)
+	setNonZero(details, UsageKeyOutput, u.Output)
+	setNonZero(details, UsageKeyTotal, u.Total)
+	setNonZero(details, UsageKeyCached, u.Cached)
+	setNonZero(details, UsageKeyReasoning, u.Reasoning)
+
+	return json.Marshal(details)
+}
+
+// UnmarshalJSON decodes a Langfuse usageDetails map into the usage
+func (u *Usage) UnmarshalJSON(data []byte) error {
+	var details map[string]int
+	if err := json.Unmarshal(data, &details); err != nil {
+		return fmt.Errorf("error unmarshalling usage details: %w", err)
+	}
+
+	*u = Usage{}
+	for k, v := range details {
+		switch k {
+		case UsageKeyInput:
+			u.Input = v
+		case UsageKeyOutput:
+			u.Output = v
+		case UsageKeyTotal:
+			u.Total = v
+		case UsageKeyCached:
+			u.Cached = v
+		case UsageKeyReasoning:
+			u.Reasoning = v
+		default:
+			if u.Other == nil {
+				u.Other = make(map[string]int)
+			}
+			u.Other[k] = v
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the cost as a Langfuse costDetails map, omitting zero amounts
+func (c Cost) MarshalJSON() ([]byte, error) {
+	details := make(map[string]float64, len(c.Other)+3)
+	for k, v := range c.Other {
+		details[k] = v
+	}
+
+	setNonZero(details, UsageKeyInput, c.Input)
+	setNonZero(details, UsageKeyOutput, c.Output)
+	setNonZero(details, UsageKeyTotal, c.Total)
+
+	return json.Marshal(details)
+}
+
+// UnmarshalJSON decodes a Langfuse costDetails map into the cost
+func (c *Cost) UnmarshalJSON(data []byte) error {
+	var details map[string]float64
+	if err := json.Unmarshal(data, &details); err != nil {
+		return fmt.Errorf("error unmarshalling cost details: %w", err)
+	}
+
+	*c = Cost{}
+	for k, v := range details {
+		switch k {
+		case UsageKeyInput:
+			c.Input = v
+		case UsageKeyOutput:
+			c.Output = v
+		case UsageKeyTotal:
+			c.Total = v
+		default:
+			if c.Other == nil {
+				c.Other = make(map[string]float64)
+			}
+			c.Other[k] = v
+		}
+	}
+
+	return nil
+}
+
+func setNonZero[T int | float64](details map[string]T, key string, value T) {
+	if value != 0 {
+		details[key] = value
+	}
+}
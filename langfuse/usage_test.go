@@ -0,0 +1,107 @@
+package langfuse
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUsage_MarshalJSON(t *testing.T) {
+	usage := Usage{
+		Input:     100,
+		Output:    50,
+		Total:     150,
+		Cached:    20,
+		Reasoning: 10,
+		Other:     map[string]int{"input_audio_tokens": 5},
+	}
+
+	data, err := json.Marshal(usage)
+	if err != nil {
+		t.Fatalf("Failed to marshal usage: %v", err)
+	}
+
+	var details map[string]int
+	if err := json.Unmarshal(data, &details); err != nil {
+		t.Fatalf("Failed to unmarshal usage details: %v", err)
+	}
+
+	expected := map[string]int{
+		"input":                   100,
+		"output":                  50,
+		"total":                   150,
+		"input_cached_tokens":     20,
+		"output_reasoning_tokens": 10,
+		"input_audio_tokens":      5,
+	}
+
+	if len(details) != len(expected) {
+		t.Fatalf("Expected %d keys, got %d: %v", len(expected), len(details), details)
+	}
+	for k, v := range expected {
+		if details[k] != v {
+			t.Errorf("Expected %s=%d, got %d", k, v, details[k])
+		}
+	}
+}
+
+func TestUsage_MarshalJSON_OmitsZeroCounts(t *testing.T) {
+	data, err := json.Marshal(Usage{Input: 10})
+	if err != nil {
+		t.Fatalf("Failed to marshal usage: %v", err)
+	}
+
+	if string(data) != `{"input":10}` {
+		t.Errorf("Expected only input key, got %s", string(data))
+	}
+}
+
+func TestUsage_UnmarshalJSON(t *testing.T) {
+	var usage Usage
+	data := `{"input":100,"output":50,"total":150,"input_cached_tokens":20,"output_reasoning_tokens":10,"custom":3}`
+	if err := json.Unmarshal([]byte(data), &usage); err != nil {
+		t.Fatalf("Failed to unmarshal usage: %v", err)
+	}
+
+	if usage.Input != 100 || usage.Output != 50 || usage.Total != 150 {
+		t.Errorf("Unexpected token counts: %+v", usage)
+	}
+	if usage.Cached != 20 || usage.Reasoning != 10 {
+		t.Errorf("Unexpected cached/reasoning counts: %+v", usage)
+	}
+	if usage.Other["custom"] != 3 {
+		t.Errorf("Expected custom=3 in Other, got %v", usage.Other)
+	}
+}
+
+func TestUsage_UnmarshalJSON_Invalid(t *testing.T) {
+	var usage Usage
+	if err := json.Unmarshal([]byte(`{"input":"many"}`), &usage); err == nil {
+		t.Fatal("Expected error for invalid usage details, got nil")
+	}
+}
+
+func TestCost_RoundTrip(t *testing.T) {
+	cost := Cost{
+		Input:  0.001,
+		Output: 0.002,
+		Total:  0.003,
+		Other:  map[string]float64{"input_cached_tokens": 0.0005},
+	}
+
+	data, err := json.Marshal(cost)
+	if err != nil {
+		t.Fatalf("Failed to marshal cost: %v", err)
+	}
+
+	var decoded Cost
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Failed to unmarshal cost: %v", err)
+	}
+
+	if decoded.Input != cost.Input || decoded.Output != cost.Output || decoded.Total != cost.Total {
+		t.Errorf("Expected %+v, got %+v", cost, decoded)
+	}
+	if decoded.Other["input_cached_tokens"] != 0.0005 {
+		t.Errorf("Expected input_cached_tokens=0.0005 in Other, got %v", decoded.Other)
+	}
+}